@@ -26,7 +26,7 @@ SELECT
 FROM
     book
 WHERE
-    title = UUID_TO_BIN(?)
+    title = ?
     AND yr = ?;
 
 /* name: bookByTags :many */
@@ -77,7 +77,7 @@ VALUES
         ?,
         ?,
         ?,
-        UUID_TO_BIN(?),
+        ?,
         ?,
         ?,
         ?
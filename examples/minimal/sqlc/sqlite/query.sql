@@ -34,7 +34,7 @@ SELECT
 FROM
     book
 WHERE
-    title = UUID_TO_BIN(?)
+    title = ?
     AND yr = ?;
 
 /* name: bookByTags :many */
@@ -85,7 +85,7 @@ VALUES
         ?,
         ?,
         ?,
-        UUID_TO_BIN(?),
+        ?,
         ?,
         ?,
         ?
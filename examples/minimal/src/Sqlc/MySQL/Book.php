@@ -15,7 +15,7 @@ final readonly class Book {
         public string $isbn,
         public string $bookType,
         // UUID
-        public string $title,
+        public \Symfony\Component\Uid\Uuid $title,
         public int $yr,
         public string $available,
         public string $tags,
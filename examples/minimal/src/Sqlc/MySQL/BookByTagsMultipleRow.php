@@ -11,7 +11,7 @@ namespace App\Sqlc\MySQL;
 final readonly class BookByTagsMultipleRow {
     public function __construct(
         public int $bookId,
-        public string $title,
+        public \Symfony\Component\Uid\Uuid $title,
         public ?string $name,
         public string $isbn,
         public string $tags,
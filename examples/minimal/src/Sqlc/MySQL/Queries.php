@@ -22,11 +22,11 @@ interface Queries {
   /**
   *  @return Book[]
   */
-  public function bookByTitleYear(string $uuidToBin, int $yr): array;
+  public function bookByTitleYear(\Symfony\Component\Uid\Uuid $title, int $yr): array;
   
   public function createAuthor(string $name): int|string;
   
-  public function createBook(int $authorId, string $isbn, string $bookType, string $uuidToBin, int $yr, string $available, string $tags): int|string;
+  public function createBook(int $authorId, string $isbn, string $bookType, \Symfony\Component\Uid\Uuid $title, int $yr, string $available, string $tags): int|string;
   
   public function deleteAuthorBeforeYear(int $yr, int $authorId): void;
   
@@ -36,9 +36,9 @@ interface Queries {
   
   public function getBook(int $bookId): ?Book;
   
-  public function updateBook(string $title, string $tags, int $bookId): void;
+  public function updateBook(\Symfony\Component\Uid\Uuid $title, string $tags, int $bookId): void;
   
-  public function updateBookISBN(string $title, string $tags, string $isbn, int $bookId): void;
+  public function updateBookISBN(\Symfony\Component\Uid\Uuid $title, string $tags, string $isbn, int $bookId): void;
   
 }
 
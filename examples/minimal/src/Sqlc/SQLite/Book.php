@@ -14,7 +14,7 @@ final readonly class Book {
         public int $authorId,
         public string $isbn,
         public string $bookType,
-        public string $title,
+        public \Symfony\Component\Uid\Uuid $title,
         public int $yr,
         public string $available,
         public string $tags,
@@ -8,7 +8,8 @@ declare(strict_types=1);
 
 namespace App\Sqlc\SQLite;
 
-const bookByTags = "-- name: bookByTags :many
+const bookByTags = <<<'SQL'
+-- name: bookByTags :many
 SELECT
     book_id,
     title,
@@ -20,9 +21,10 @@ FROM
     LEFT JOIN author ON book.author_id = author.author_id
 WHERE
     tags = ?
-";
+SQL;
 
-const bookByTagsMultiple = "-- name: bookByTagsMultiple :many
+const bookByTagsMultiple = <<<'SQL'
+-- name: bookByTagsMultiple :many
 SELECT
     book_id,
     title,
@@ -34,26 +36,29 @@ FROM
     LEFT JOIN author ON book.author_id = author.author_id
 WHERE
     tags IN (/*SLICE:tags*/?)
-";
+SQL;
 
-const bookByTitleYear = "-- name: bookByTitleYear :many
+const bookByTitleYear = <<<'SQL'
+-- name: bookByTitleYear :many
 SELECT
     book_id, author_id, isbn, book_type, title, yr, available, tags
 FROM
     book
 WHERE
-    title = UUID_TO_BIN(?)
+    title = ?
     AND yr = ?
-";
+SQL;
 
-const createAuthor = "-- name: createAuthor :execresult
+const createAuthor = <<<'SQL'
+-- name: createAuthor :execresult
 INSERT INTO
     author (name)
 VALUES
     (?)
-";
+SQL;
 
-const createBook = "-- name: createBook :execresult
+const createBook = <<<'SQL'
+-- name: createBook :execresult
 INSERT INTO
     book (
         author_id,
@@ -69,56 +74,62 @@ VALUES
         ?,
         ?,
         ?,
-        UUID_TO_BIN(?),
+        ?,
         ?,
         ?,
         ?
     )
-";
+SQL;
 
-const deleteAuthorBeforeYear = "-- name: deleteAuthorBeforeYear :exec
+const deleteAuthorBeforeYear = <<<'SQL'
+-- name: deleteAuthorBeforeYear :exec
 DELETE FROM
     book
 WHERE
     yr < ?
     AND author_id = ?
-";
+SQL;
 
-const deleteBook = "-- name: deleteBook :exec
+const deleteBook = <<<'SQL'
+-- name: deleteBook :exec
 DELETE FROM
     book
 WHERE
     book_id = ?
-";
+SQL;
 
-const getAuthor = "-- name: getAuthor :one
+const getAuthor = <<<'SQL'
+-- name: getAuthor :one
 SELECT
     author_id, name
 FROM
     author
 WHERE
     author_id = ?
-";
+SQL;
 
-const getBook = "-- name: getBook :one
+const getBook = <<<'SQL'
+-- name: getBook :one
 SELECT
     book_id, author_id, isbn, book_type, title, yr, available, tags
 FROM
     book
 WHERE
     book_id = ?
-";
+SQL;
 
-const listAuthors = "-- name: listAuthors :many
+const listAuthors = <<<'SQL'
+-- name: listAuthors :many
 SELECT
     author_id, name
 FROM
     author
 ORDER BY
     name
-";
+SQL;
 
-const updateBook = "-- name: updateBook :exec
+const updateBook = <<<'SQL'
+-- name: updateBook :exec
 UPDATE
     book
 SET
@@ -126,9 +137,10 @@ SET
     tags = ?
 WHERE
     book_id = ?
-";
+SQL;
 
-const updateBookISBN = "-- name: updateBookISBN :exec
+const updateBookISBN = <<<'SQL'
+-- name: updateBookISBN :exec
 UPDATE
     book
 SET
@@ -137,11 +149,21 @@ SET
     isbn = ?
 WHERE
     book_id = ?
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
 
+    /**
+     * Rewrites a sqlc.slice() marker into one placeholder per value. An empty
+     * list becomes NULL so that "IN (...)" matches nothing instead of failing.
+     */
+    private static function expandSlice(string $query, string $name, array $values): string
+    {
+        $placeholders = $values === [] ? 'NULL' : implode(', ', array_fill(0, count($values), '?'));
+        return str_replace('/*SLICE:' . $name . '*/?', $placeholders, $query);
+    }
+
     /**
      * @return BookByTagsRow[]
      * @throws \Exception
@@ -149,11 +171,12 @@ final readonly class QueriesImpl implements Queries {
     public function bookByTags(string $tags): array
     {
         $stmt = $this->pdo->prepare(bookByTags);
-        $stmt->execute([$tags]);
+        $stmt->bindValue(1, $tags, \PDO::PARAM_STR);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         $ret = [];
         foreach ($results as $row) {
-            $ret[] = new BookByTagsRow($row[0], $row[1], $row[2], $row[3], $row[4]);
+            $ret[] = new BookByTagsRow($row[0], \Symfony\Component\Uid\Uuid::fromBinary($row[1]), $row[2], $row[3], $row[4]);
         }
         return $ret;
     }
@@ -164,12 +187,14 @@ final readonly class QueriesImpl implements Queries {
      */
     public function bookByTagsMultiple(array $tags): array
     {
-        $stmt = $this->pdo->prepare(bookByTagsMultiple);
-        $stmt->execute([$tags]);
+        $stmt = $this->pdo->prepare(self::expandSlice(bookByTagsMultiple, 'tags', $tags));
+        $i = 1;
+        foreach (array_values($tags) as $element) { $stmt->bindValue($i++, $element, \PDO::PARAM_STR); }
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         $ret = [];
         foreach ($results as $row) {
-            $ret[] = new BookByTagsMultipleRow($row[0], $row[1], $row[2], $row[3], $row[4]);
+            $ret[] = new BookByTagsMultipleRow($row[0], \Symfony\Component\Uid\Uuid::fromBinary($row[1]), $row[2], $row[3], $row[4]);
         }
         return $ret;
     }
@@ -178,14 +203,16 @@ final readonly class QueriesImpl implements Queries {
      * @return Book[]
      * @throws \Exception
      */
-    public function bookByTitleYear(mixed $uuidToBin, int $yr): array
+    public function bookByTitleYear(\Symfony\Component\Uid\Uuid $title, int $yr): array
     {
         $stmt = $this->pdo->prepare(bookByTitleYear);
-        $stmt->execute([$uuidToBin, $yr]);
+        $stmt->bindValue(1, $title->toBinary(), \PDO::PARAM_LOB);
+        $stmt->bindValue(2, $yr, \PDO::PARAM_INT);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         $ret = [];
         foreach ($results as $row) {
-            $ret[] = new Book($row[0], $row[1], $row[2], $row[3], $row[4], $row[5], $row[6], $row[7]);
+            $ret[] = new Book($row[0], $row[1], $row[2], $row[3], \Symfony\Component\Uid\Uuid::fromBinary($row[4]), $row[5], $row[6], $row[7]);
         }
         return $ret;
     }
@@ -195,16 +222,24 @@ final readonly class QueriesImpl implements Queries {
      */
     public function createAuthor(string $name): int|string {
         $stmt = $this->pdo->prepare(createAuthor);
-        $stmt->execute([$name]);
+        $stmt->bindValue(1, $name, \PDO::PARAM_STR);
+        $stmt->execute();
         return $this->pdo->lastInsertId();
     }
 
     /**
      * @throws \Exception
      */
-    public function createBook(int $authorId, string $isbn, string $bookType, mixed $uuidToBin, int $yr, string $available, string $tags): int|string {
+    public function createBook(int $authorId, string $isbn, string $bookType, \Symfony\Component\Uid\Uuid $title, int $yr, string $available, string $tags): int|string {
         $stmt = $this->pdo->prepare(createBook);
-        $stmt->execute([$authorId, $isbn, $bookType, $uuidToBin, $yr, $available, $tags]);
+        $stmt->bindValue(1, $authorId, \PDO::PARAM_INT);
+        $stmt->bindValue(2, $isbn, \PDO::PARAM_STR);
+        $stmt->bindValue(3, $bookType, \PDO::PARAM_STR);
+        $stmt->bindValue(4, $title->toBinary(), \PDO::PARAM_LOB);
+        $stmt->bindValue(5, $yr, \PDO::PARAM_INT);
+        $stmt->bindValue(6, $available, \PDO::PARAM_STR);
+        $stmt->bindValue(7, $tags, \PDO::PARAM_STR);
+        $stmt->execute();
         return $this->pdo->lastInsertId();
     }
 
@@ -214,7 +249,9 @@ final readonly class QueriesImpl implements Queries {
     public function deleteAuthorBeforeYear(int $yr, int $authorId): void
     {
         $stmt = $this->pdo->prepare(deleteAuthorBeforeYear);
-        $stmt->execute([$yr, $authorId]);
+        $stmt->bindValue(1, $yr, \PDO::PARAM_INT);
+        $stmt->bindValue(2, $authorId, \PDO::PARAM_INT);
+        $stmt->execute();
     }
 
     /**
@@ -223,7 +260,8 @@ final readonly class QueriesImpl implements Queries {
     public function deleteBook(int $bookId): void
     {
         $stmt = $this->pdo->prepare(deleteBook);
-        $stmt->execute([$bookId]);
+        $stmt->bindValue(1, $bookId, \PDO::PARAM_INT);
+        $stmt->execute();
     }
 
     /**
@@ -233,7 +271,8 @@ final readonly class QueriesImpl implements Queries {
     public function getAuthor(int $authorId): ?Author
     {
         $stmt = $this->pdo->prepare(getAuthor);
-        $stmt->execute([$authorId]);
+        $stmt->bindValue(1, $authorId, \PDO::PARAM_INT);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         {
             $count = count($results);
@@ -257,7 +296,8 @@ final readonly class QueriesImpl implements Queries {
     public function getBook(int $bookId): ?Book
     {
         $stmt = $this->pdo->prepare(getBook);
-        $stmt->execute([$bookId]);
+        $stmt->bindValue(1, $bookId, \PDO::PARAM_INT);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         {
             $count = count($results);
@@ -271,7 +311,7 @@ final readonly class QueriesImpl implements Queries {
         }
 
         $row = $results[0];
-        return new Book($row[0], $row[1], $row[2], $row[3], $row[4], $row[5], $row[6], $row[7]);
+        return new Book($row[0], $row[1], $row[2], $row[3], \Symfony\Component\Uid\Uuid::fromBinary($row[4]), $row[5], $row[6], $row[7]);
     }
 
     /**
@@ -293,19 +333,26 @@ final readonly class QueriesImpl implements Queries {
     /**
      * @throws \Exception
      */
-    public function updateBook(string $title, string $tags, int $bookId): void
+    public function updateBook(\Symfony\Component\Uid\Uuid $title, string $tags, int $bookId): void
     {
         $stmt = $this->pdo->prepare(updateBook);
-        $stmt->execute([$title, $tags, $bookId]);
+        $stmt->bindValue(1, $title->toBinary(), \PDO::PARAM_LOB);
+        $stmt->bindValue(2, $tags, \PDO::PARAM_STR);
+        $stmt->bindValue(3, $bookId, \PDO::PARAM_INT);
+        $stmt->execute();
     }
 
     /**
      * @throws \Exception
      */
-    public function updateBookISBN(string $title, string $tags, string $isbn, int $bookId): void
+    public function updateBookISBN(\Symfony\Component\Uid\Uuid $title, string $tags, string $isbn, int $bookId): void
     {
         $stmt = $this->pdo->prepare(updateBookISBN);
-        $stmt->execute([$title, $tags, $isbn, $bookId]);
+        $stmt->bindValue(1, $title->toBinary(), \PDO::PARAM_LOB);
+        $stmt->bindValue(2, $tags, \PDO::PARAM_STR);
+        $stmt->bindValue(3, $isbn, \PDO::PARAM_STR);
+        $stmt->bindValue(4, $bookId, \PDO::PARAM_INT);
+        $stmt->execute();
     }
 
 }
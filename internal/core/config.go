@@ -1,5 +1,215 @@
 package core
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
 type Config struct {
 	Package string `json:"package"`
+	// ThrowOnNoRows makes :one and :batchone throw a NoRowsException instead
+	// of returning null when the query matches no row.
+	ThrowOnNoRows bool       `json:"throw_on_no_rows"`
+	Overrides     []Override `json:"overrides"`
+	// DateTimeImmutable maps date, datetime, timestamp and time columns to
+	// \DateTimeImmutable.
+	DateTimeImmutable bool `json:"date_time_immutable"`
+	// DateTimeTimezone is the timezone temporal values are stored in. Values
+	// are hydrated in it and converted to it when bound. When empty, PHP's
+	// default timezone is used and bound values are not converted.
+	DateTimeTimezone string `json:"date_time_timezone"`
+	// SQLiteDateTimeStorage is either "text" (the default) for ISO 8601
+	// strings or "unixepoch" for integer timestamps.
+	SQLiteDateTimeStorage string `json:"sqlite_date_time_storage"`
+	// DecimalType is "bcmath" for \BcMath\Number, or a class constructed
+	// from the decimal string. Decimals stay strings when empty.
+	DecimalType string `json:"decimal_type"`
+	// UnsignedBigIntType is the PHP type of MySQL BIGINT UNSIGNED columns:
+	// "int|string" (the default), "string", "bcmath" or a class constructed
+	// from the numeric string.
+	UnsignedBigIntType string `json:"unsigned_bigint_type"`
+	// JSONClasses maps JSON columns named "table.column" or
+	// "schema.table.column" to the class their values are hydrated into.
+	JSONClasses map[string]string `json:"json_classes"`
+	// JSONDecode is "assoc" (the default) to decode JSON objects into
+	// associative arrays or "object" to decode them into \stdClass.
+	JSONDecode string `json:"json_decode"`
+	// JSONThrowOnError decodes and encodes JSON with JSON_THROW_ON_ERROR.
+	// Malformed columns raise a generated JsonDecodeException.
+	JSONThrowOnError bool `json:"json_throw_on_error"`
+	// JSONDecodeFlags and JSONEncodeFlags are JSON_* constants passed to
+	// json_decode and json_encode, e.g. JSON_BIGINT_AS_STRING.
+	JSONDecodeFlags []string `json:"json_decode_flags"`
+	JSONEncodeFlags []string `json:"json_encode_flags"`
+	// BlobType is the PHP type of BLOB columns: "string" (the default),
+	// "stream" for stream resources or "blob" for the generated Blob class.
+	BlobType string `json:"blob_type"`
+	// UUIDType is the UUID library, "symfony" or "ramsey", whose class the
+	// UUIDColumns, named "table.column" or "schema.table.column", map to.
+	UUIDType    string   `json:"uuid_type"`
+	UUIDColumns []string `json:"uuid_columns"`
+	// Converters route columns through PHP ColumnConverter classes.
+	Converters []Converter `json:"converters"`
+}
+
+// Override replaces the PHP type generated for a database type, or for a
+// single column named "table.column" or "schema.table.column". Decode and
+// Encode are PHP expressions in which $value stands for the database value
+// and the PHP value respectively.
+type Override struct {
+	DBType   string `json:"db_type"`
+	Column   string `json:"column"`
+	Nullable bool   `json:"nullable"`
+	Engine   string `json:"engine"`
+	PHPType  string `json:"php_type"`
+	Decode   string `json:"decode"`
+	Encode   string `json:"encode"`
+}
+
+func (c *Config) Validate() error {
+	switch c.SQLiteDateTimeStorage {
+	case "", sqliteDateTimeText, sqliteDateTimeUnixEpoch:
+	default:
+		return fmt.Errorf("sqlite_date_time_storage: unknown storage %q, want %q or %q",
+			c.SQLiteDateTimeStorage, sqliteDateTimeText, sqliteDateTimeUnixEpoch)
+	}
+
+	switch c.BlobType {
+	case "", blobString, blobStream, blobObject:
+	default:
+		return fmt.Errorf("blob_type: unknown type %q, want %q, %q or %q", c.BlobType, blobString, blobStream, blobObject)
+	}
+
+	if _, ok := uuidCodecs[c.UUIDType]; !ok && (c.UUIDType != "" || len(c.UUIDColumns) > 0) {
+		return fmt.Errorf("uuid_type: unknown library %q, want %q or %q", c.UUIDType, uuidSymfony, uuidRamsey)
+	}
+
+	for _, column := range c.UUIDColumns {
+		if err := validateColumnKey("uuid_columns", column); err != nil {
+			return err
+		}
+	}
+
+	switch c.JSONDecode {
+	case "", jsonDecodeAssoc, jsonDecodeObject:
+	default:
+		return fmt.Errorf("json_decode: unknown mode %q, want %q or %q", c.JSONDecode, jsonDecodeAssoc, jsonDecodeObject)
+	}
+
+	for _, flag := range append(append([]string{}, c.JSONDecodeFlags...), c.JSONEncodeFlags...) {
+		if !jsonFlag.MatchString(flag) {
+			return fmt.Errorf("json flags: %q is not a JSON_* constant", flag)
+		}
+	}
+
+	for column := range c.JSONClasses {
+		if err := validateColumnKey("json_classes", column); err != nil {
+			return err
+		}
+	}
+
+	for i, cv := range c.Converters {
+		if err := validateColumnKey(fmt.Sprintf("converters[%d]", i), cv.Column); err != nil {
+			return err
+		}
+		if cv.Class == "" {
+			return fmt.Errorf("converters[%d]: class is required", i)
+		}
+	}
+
+	for i, o := range c.Overrides {
+		if (o.DBType == "") == (o.Column == "") {
+			return fmt.Errorf("overrides[%d]: exactly one of db_type and column is required", i)
+		}
+		if o.Column != "" {
+			if err := validateColumnKey(fmt.Sprintf("overrides[%d]", i), o.Column); err != nil {
+				return err
+			}
+		}
+		if o.PHPType == "" {
+			return fmt.Errorf("overrides[%d]: php_type is required", i)
+		}
+	}
+
+	return nil
+}
+
+// validateColumnKey checks that a column of option is named
+// "table.column" or "schema.table.column".
+func validateColumnKey(option, column string) error {
+	if n := len(strings.Split(column, ".")); n != 2 && n != 3 {
+		return fmt.Errorf("%s: column %q must be table.column or schema.table.column", option, column)
+	}
+
+	return nil
+}
+
+// qualifiedClass returns the fully qualified name of a configured class.
+func qualifiedClass(class string) string {
+	return "\\" + strings.TrimPrefix(class, "\\")
+}
+
+// matches reports whether the override applies to a column of the given
+// database type and nullability generated for engine.
+func (o Override) matches(engine, dbType string, nullable bool) bool {
+	if o.Engine != "" && o.Engine != engine {
+		return false
+	}
+
+	return o.DBType != "" && o.Nullable == nullable && strings.EqualFold(o.DBType, dbType)
+}
+
+// matchesColumn reports whether the column override applies to the column
+// name of table for engine. Tables without a schema are in defaultSchema.
+func (o Override) matchesColumn(engine, defaultSchema string, table *plugin.Identifier, name string) bool {
+	if o.Column == "" || table == nil || name == "" || (o.Engine != "" && o.Engine != engine) {
+		return false
+	}
+
+	schema := table.Schema
+	if schema == "" {
+		schema = defaultSchema
+	}
+
+	parts := strings.Split(o.Column, ".")
+	if len(parts) == 2 {
+		parts = append([]string{defaultSchema}, parts...)
+	}
+
+	return len(parts) == 3 &&
+		strings.EqualFold(parts[0], schema) &&
+		strings.EqualFold(parts[1], table.Name) &&
+		strings.EqualFold(parts[2], name)
+}
+
+// apply replaces the PHP type of t with the override.
+func (o Override) apply(t phpType) phpType {
+	t.Name = o.phpTypeName()
+	t.Decode = o.Decode
+	t.Encode = o.Encode
+	t.EncodedType = ""
+	t.DocName = ""
+	t.IsEnumList = false
+	return t
+}
+
+// phpTypeName returns the configured PHP type, fully qualifying class names.
+func (o Override) phpTypeName() string {
+	name := strings.TrimPrefix(o.PHPType, "?")
+	if strings.Contains(name, "\\") {
+		return qualifiedClass(name)
+	}
+
+	return name
+}
+
+var valuePlaceholder = regexp.MustCompile(`\$value\b`)
+
+// expand substitutes the PHP expression v for $value in an encode or decode
+// expression.
+func expand(expr, v string) string {
+	return valuePlaceholder.ReplaceAllLiteralString(expr, v)
 }
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	conf := &Config{Overrides: []Override{{DBType: "decimal", PHPType: "string"}}}
+	if err := conf.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	conf.Overrides = append(conf.Overrides, Override{DBType: "json"})
+	if err := conf.Validate(); err == nil {
+		t.Errorf("Expected an error for an override without php_type")
+	}
+
+	if err := (&Config{SQLiteDateTimeStorage: "julian"}).Validate(); err == nil {
+		t.Errorf("Expected an error for an unknown sqlite_date_time_storage")
+	}
+
+	for _, o := range []Override{
+		{DBType: "text", Column: "book.isbn", PHPType: "string"},
+		{Column: "isbn", PHPType: "string"},
+	} {
+		if err := (&Config{Overrides: []Override{o}}).Validate(); err == nil {
+			t.Errorf("Expected an error for %+v", o)
+		}
+	}
+
+	if err := (&Config{JSONClasses: map[string]string{"data": `App\Profile`}}).Validate(); err == nil {
+		t.Errorf("Expected an error for a json_classes column without table")
+	}
+
+	if err := (&Config{UUIDColumns: []string{"book.id"}}).Validate(); err == nil {
+		t.Errorf("Expected an error for uuid_columns without uuid_type")
+	}
+
+	if err := (&Config{UUIDType: "symfony", UUIDColumns: []string{"id"}}).Validate(); err == nil {
+		t.Errorf("Expected an error for a uuid_columns column without table")
+	}
+
+	if err := (&Config{Converters: []Converter{{Column: "user.email"}}}).Validate(); err == nil {
+		t.Errorf("Expected an error for a converter without class")
+	}
+
+	if err := (&Config{Converters: []Converter{{Column: "email", Class: `App\Crypto`}}}).Validate(); err == nil {
+		t.Errorf("Expected an error for a converter column without table")
+	}
+
+	if err := (&Config{BlobType: "resource"}).Validate(); err == nil {
+		t.Errorf("Expected an error for an unknown blob_type")
+	}
+
+	if err := (&Config{JSONDecode: "stdclass"}).Validate(); err == nil {
+		t.Errorf("Expected an error for an unknown json_decode mode")
+	}
+
+	if err := (&Config{JSONDecodeFlags: []string{"JSON_BIGINT_AS_STRING | 1"}}).Validate(); err == nil {
+		t.Errorf("Expected an error for a flag that is not a JSON_* constant")
+	}
+}
+
+func TestOverride_matchesColumn(t *testing.T) {
+	book := &plugin.Identifier{Name: "book"}
+	tests := []struct {
+		column   string
+		table    *plugin.Identifier
+		name     string
+		expected bool
+	}{
+		{"book.isbn", book, "isbn", true},
+		{"main.book.isbn", book, "isbn", true},
+		{"book.isbn", &plugin.Identifier{Schema: "main", Name: "book"}, "isbn", true},
+		{"book.isbn", &plugin.Identifier{Schema: "other", Name: "book"}, "isbn", false},
+		{"other.book.isbn", &plugin.Identifier{Schema: "other", Name: "book"}, "isbn", true},
+		{"book.isbn", book, "title", false},
+		{"book.isbn", nil, "isbn", false},
+	}
+
+	for _, tt := range tests {
+		o := Override{Column: tt.column, PHPType: "string"}
+		if got := o.matchesColumn("sqlite", "main", tt.table, tt.name); got != tt.expected {
+			t.Errorf("matchesColumn(%q, %+v, %q) = %v, want %v", tt.column, tt.table, tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestOverride_phpTypeName(t *testing.T) {
+	tests := map[string]string{
+		"string":                 "string",
+		`Brick\Math\BigDecimal`:  `\Brick\Math\BigDecimal`,
+		`\Brick\Math\BigDecimal`: `\Brick\Math\BigDecimal`,
+		`?App\Money`:             `\App\Money`,
+	}
+
+	for in, expected := range tests {
+		if got := (Override{PHPType: in}).phpTypeName(); got != expected {
+			t.Errorf("phpTypeName(%q) = %q, want %q", in, got, expected)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	if got := expand("Money::of($value, $values)", "$row[0]"); got != "Money::of($row[0], $values)" {
+		t.Errorf("expand() = %q", got)
+	}
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+	"github.com/sqlc-dev/plugin-sdk-go/sdk"
+)
+
+// Converter routes the values of a column, named "table.column" or
+// "schema.table.column", through a PHP class implementing the generated
+// ColumnConverter interface. PHPType is the type toPhp returns; the column's
+// type is kept when it is empty.
+type Converter struct {
+	Column  string `json:"column"`
+	Class   string `json:"class"`
+	PHPType string `json:"php_type"`
+}
+
+// ConverterDependency is a converter instance QueriesImpl takes as a
+// constructor dependency.
+type ConverterDependency struct {
+	Class string
+	Name  string
+}
+
+// BuildConverterDependencies returns one dependency per converter class, in
+// the order the classes are first configured.
+func BuildConverterDependencies(conf *Config) []ConverterDependency {
+	var deps []ConverterDependency
+	seen := map[string]bool{}
+	names := map[string]int{}
+	for _, c := range conf.Converters {
+		class := qualifiedClass(c.Class)
+		if seen[class] {
+			continue
+		}
+		seen[class] = true
+
+		short := class[strings.LastIndex(class, "\\")+1:]
+		name := sdk.LowerTitle(short)
+		if n := names[name]; n > 0 {
+			name = fmt.Sprintf("%s%d", name, n+1)
+		}
+		names[sdk.LowerTitle(short)]++
+
+		deps = append(deps, ConverterDependency{Class: class, Name: name})
+	}
+
+	return deps
+}
+
+// converterType routes the column name of table through its configured
+// converter. The converter receives the raw database value, so it replaces
+// every other mapping of the column.
+func converterType(conf *Config, defaultSchema string, table *plugin.Identifier, name string, t phpType) (phpType, bool) {
+	for _, c := range conf.Converters {
+		if !(Override{Column: c.Column}).matchesColumn(t.Engine, defaultSchema, table, name) {
+			continue
+		}
+
+		property := ""
+		for _, dep := range BuildConverterDependencies(conf) {
+			if dep.Class == qualifiedClass(c.Class) {
+				property = dep.Name
+				break
+			}
+		}
+
+		// toDb returns the database representation of the column.
+		t.EncodedType = t.Name
+		if c.PHPType != "" {
+			t.Name = (Override{PHPType: c.PHPType}).phpTypeName()
+		}
+		t.Decode = "$this->" + property + "->toPhp($value)"
+		t.Encode = "$this->" + property + "->toDb($value)"
+		t.DocName = ""
+		t.IsEnumList = false
+		return t, true
+	}
+
+	return t, false
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestBuildConverterDependencies(t *testing.T) {
+	conf := &Config{Converters: []Converter{
+		{Column: "user.email", Class: `App\Db\EncryptedStringConverter`},
+		{Column: "user.balance", Class: `\App\Db\MoneyConverter`},
+		{Column: "order.total", Class: `App\Db\MoneyConverter`},
+		{Column: "invoice.total", Class: `App\Legacy\MoneyConverter`},
+	}}
+
+	expected := []ConverterDependency{
+		{Class: `\App\Db\EncryptedStringConverter`, Name: "encryptedStringConverter"},
+		{Class: `\App\Db\MoneyConverter`, Name: "moneyConverter"},
+		{Class: `\App\Legacy\MoneyConverter`, Name: "moneyConverter2"},
+	}
+	if got := BuildConverterDependencies(conf); !reflect.DeepEqual(got, expected) {
+		t.Errorf("BuildConverterDependencies() = %+v, want %+v", got, expected)
+	}
+}
+
+func TestConverterType(t *testing.T) {
+	user := &plugin.Identifier{Name: "user"}
+	conf := &Config{Converters: []Converter{
+		{Column: "user.email", Class: `App\Db\EncryptedStringConverter`},
+		{Column: "user.balance", Class: `App\Db\MoneyConverter`, PHPType: `App\Money`},
+	}}
+
+	typ, ok := converterType(conf, "public", user, "email", phpType{Name: "string", Engine: "mysql"})
+	if !ok || typ.Name != "string" || typ.EncodedType != "string" ||
+		typ.Decode != "$this->encryptedStringConverter->toPhp($value)" ||
+		typ.Encode != "$this->encryptedStringConverter->toDb($value)" {
+		t.Errorf("converterType() = %+v, %v", typ, ok)
+	}
+
+	typ, _ = converterType(conf, "public", user, "balance", phpType{Name: "string", IsNull: true, Engine: "mysql"})
+	if typ.Name != `\App\Money` || typ.EncodedType != "string" {
+		t.Errorf("converterType() = %+v", typ)
+	}
+
+	expected := "$stmt->bindValue(1, $balance === null ? null : $this->moneyConverter->toDb($balance), $balance === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);"
+	if got := bindValue("1", typ, "$balance"); got != expected {
+		t.Errorf("bindValue() = %q, want %q", got, expected)
+	}
+
+	if _, ok := converterType(conf, "public", user, "name", phpType{Name: "string", Engine: "mysql"}); ok {
+		t.Errorf("Expected no converter for an unconfigured column")
+	}
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	sqliteDateTimeText      = "text"
+	sqliteDateTimeUnixEpoch = "unixepoch"
+)
+
+// dateTimeFormats are the DateTimeImmutable::format() strings of the temporal
+// database types, shared by MySQL and SQLite text storage.
+var dateTimeFormats = map[string]string{
+	"date":      "Y-m-d",
+	"datetime":  "Y-m-d H:i:s",
+	"timestamp": "Y-m-d H:i:s",
+	"time":      "H:i:s",
+}
+
+// parseDateTimeHelper is the QueriesImpl method hydrating text values.
+const parseDateTimeHelper = "self::parseDateTime("
+
+// dateTimeType maps a temporal column to \DateTimeImmutable when the
+// date_time_immutable option is set.
+func dateTimeType(conf *Config, t phpType) (phpType, bool) {
+	format, ok := dateTimeFormats[strings.ToLower(t.DataType)]
+	if !conf.DateTimeImmutable || !ok {
+		return t, false
+	}
+
+	t.Name = "\\DateTimeImmutable"
+	timezone := "null"
+	if conf.DateTimeTimezone != "" {
+		timezone = fmt.Sprintf("new \\DateTimeZone(%s)", phpStringLiteral(conf.DateTimeTimezone))
+	}
+
+	if t.Engine == "sqlite" && conf.SQLiteDateTimeStorage == sqliteDateTimeUnixEpoch {
+		if timezone == "null" {
+			timezone = "new \\DateTimeZone(date_default_timezone_get())"
+		}
+
+		t.Decode = fmt.Sprintf("(new \\DateTimeImmutable('@' . $value))->setTimezone(%s)", timezone)
+		t.Encode = "$value->getTimestamp()"
+		t.EncodedType = "int"
+		return t, true
+	}
+
+	// The "!" resets the fields missing from the format, such as the time of
+	// a date, instead of taking them from the current time.
+	t.Decode = fmt.Sprintf("%s'!%s', $value, %s)", parseDateTimeHelper, format, timezone)
+	t.Encode = fmt.Sprintf("$value->format('%s')", format)
+	// Dates and times of day are local values, only instants are converted.
+	if timezone != "null" && strings.Contains(format, "d H") {
+		t.Encode = fmt.Sprintf("$value->setTimezone(%s)->format('%s')", timezone, format)
+	}
+
+	return t, true
+}
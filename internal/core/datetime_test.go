@@ -0,0 +1,37 @@
+package core
+
+import "testing"
+
+func TestDateTimeType(t *testing.T) {
+	datetime := phpType{Name: "string", DataType: "DATETIME", Engine: "sqlite"}
+	if _, ok := dateTimeType(&Config{}, datetime); ok {
+		t.Errorf("Expected no mapping without date_time_immutable")
+	}
+
+	conf := &Config{DateTimeImmutable: true, DateTimeTimezone: "UTC"}
+	typ, ok := dateTimeType(conf, datetime)
+	if !ok || typ.Name != "\\DateTimeImmutable" {
+		t.Fatalf("dateTimeType() = %+v, %v", typ, ok)
+	}
+	if expected := "self::parseDateTime('!Y-m-d H:i:s', $value, new \\DateTimeZone('UTC'))"; typ.Decode != expected {
+		t.Errorf("Decode = %q, want %q", typ.Decode, expected)
+	}
+	if expected := "$value->setTimezone(new \\DateTimeZone('UTC'))->format('Y-m-d H:i:s')"; typ.Encode != expected {
+		t.Errorf("Encode = %q, want %q", typ.Encode, expected)
+	}
+
+	date, _ := dateTimeType(conf, phpType{Name: "string", DataType: "date", Engine: "mysql"})
+	if expected := "$value->format('Y-m-d')"; date.Encode != expected {
+		t.Errorf("Encode = %q, want %q", date.Encode, expected)
+	}
+
+	conf.SQLiteDateTimeStorage = sqliteDateTimeUnixEpoch
+	epoch, _ := dateTimeType(conf, datetime)
+	if epoch.Encode != "$value->getTimestamp()" || epoch.EncodedType != "int" {
+		t.Errorf("dateTimeType() = %+v", epoch)
+	}
+
+	if _, ok := dateTimeType(conf, phpType{Name: "string", DataType: "TEXT", Engine: "sqlite"}); ok {
+		t.Errorf("Expected no mapping for a text column")
+	}
+}
@@ -0,0 +1,33 @@
+package core
+
+import "strings"
+
+// decimalBCMath selects PHP 8.4's arbitrary-precision \BcMath\Number.
+const decimalBCMath = "bcmath"
+
+var decimalDataTypes = map[string]bool{
+	"decimal": true,
+	"dec":     true,
+	"fixed":   true,
+	"numeric": true,
+}
+
+// decimalType maps a decimal column to the class configured by the
+// decimal_type option. The class is constructed from the decimal string and
+// cast back to a string when bound.
+func decimalType(conf *Config, t phpType) (phpType, bool) {
+	if conf.DecimalType == "" || !decimalDataTypes[strings.ToLower(baseDataType(t.DataType))] {
+		return t, false
+	}
+
+	t.Name = qualifiedClass(conf.DecimalType)
+	if conf.DecimalType == decimalBCMath {
+		t.Name = "\\BcMath\\Number"
+	}
+
+	// SQLite returns NUMERIC values as int or float.
+	t.Decode = "new " + t.Name + "((string) $value)"
+	t.Encode = "(string) $value"
+	t.EncodedType = "string"
+	return t, true
+}
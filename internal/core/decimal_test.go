@@ -0,0 +1,29 @@
+package core
+
+import "testing"
+
+func TestDecimalType(t *testing.T) {
+	decimal := phpType{Name: "string", DataType: "decimal", Engine: "mysql"}
+	if _, ok := decimalType(&Config{}, decimal); ok {
+		t.Errorf("Expected no mapping without decimal_type")
+	}
+
+	typ, ok := decimalType(&Config{DecimalType: "bcmath"}, decimal)
+	if !ok || typ.Name != "\\BcMath\\Number" || typ.Decode != "new \\BcMath\\Number((string) $value)" || typ.Encode != "(string) $value" {
+		t.Errorf("decimalType() = %+v, %v", typ, ok)
+	}
+
+	numeric := phpType{Name: "string", DataType: "NUMERIC", Engine: "sqlite"}
+	if typ, _ := decimalType(&Config{DecimalType: `App\Decimal`}, numeric); typ.Name != `\App\Decimal` {
+		t.Errorf("decimalType() = %+v", typ)
+	}
+
+	precise := phpType{Name: "int|float|string", DataType: "NUMERIC(10, 2)", Engine: "sqlite"}
+	if typ, _ := decimalType(&Config{DecimalType: `App\Decimal`}, precise); typ.Name != `\App\Decimal` {
+		t.Errorf("decimalType() with precision = %+v", typ)
+	}
+
+	if _, ok := decimalType(&Config{DecimalType: "bcmath"}, phpType{Name: "float", DataType: "double"}); ok {
+		t.Errorf("Expected no mapping for a double column")
+	}
+}
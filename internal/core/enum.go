@@ -0,0 +1,127 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+var enumCaseSeparator = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// enumCaseName turns an enum value into a PHP case name, e.g. "hard-cover"
+// into "HardCover". Values that do not start with a letter are prefixed.
+func enumCaseName(value string) string {
+	name := ""
+	for _, p := range enumCaseSeparator.Split(value, -1) {
+		if p != "" {
+			name += strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		}
+	}
+
+	switch {
+	case name == "":
+		return "Empty"
+	case name[0] >= '0' && name[0] <= '9':
+		return "Value" + name
+	case strings.EqualFold(name, "class"):
+		// Enum::class is the class name constant.
+		return name + "_"
+	}
+
+	return name
+}
+
+func enumClassName(req *plugin.GenerateRequest, schema *plugin.Schema, enum *plugin.Enum) string {
+	if schema.Name == req.Catalog.DefaultSchema {
+		return dataClassName(enum.Name)
+	}
+
+	return dataClassName(schema.Name + "_" + enum.Name)
+}
+
+func BuildEnums(req *plugin.GenerateRequest) []*EnumClass {
+	var enums []*EnumClass
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+
+		for _, enum := range schema.Enums {
+			e := &EnumClass{Name: enumClassName(req, schema, enum), Comment: enum.Comment}
+			seen := map[string]int{}
+			for _, v := range enum.Vals {
+				name := enumCaseName(v)
+				if n := seen[name]; n > 0 {
+					seen[name]++
+					name = fmt.Sprintf("%s%d", name, n+1)
+				} else {
+					seen[name] = 1
+				}
+
+				e.Cases = append(e.Cases, EnumCase{Name: name, Value: phpStringLiteral(v)})
+			}
+			enums = append(enums, e)
+		}
+	}
+
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
+	return enums
+}
+
+// enumType maps a column whose type is a catalog enum to its backed enum.
+func enumType(req *plugin.GenerateRequest, col *plugin.Column, t phpType) (phpType, bool) {
+	if col.Type == nil {
+		return t, false
+	}
+
+	for _, schema := range req.GetCatalog().GetSchemas() {
+		if col.Type.Schema != "" && col.Type.Schema != schema.Name {
+			continue
+		}
+
+		for _, enum := range schema.Enums {
+			if enum.Name != col.Type.Name {
+				continue
+			}
+
+			t.Name = enumClassName(req, schema, enum)
+			t.EncodedType = "string"
+			if t.Engine == "mysql" && isMySQLSet(col, enum) {
+				t.IsEnumList = true
+				t.Decode = fmt.Sprintf(
+					"array_map(static fn (string $v): %[1]s => %[1]s::from($v), $value === '' ? [] : explode(',', $value))",
+					t.Name,
+				)
+				t.Encode = fmt.Sprintf("implode(',', array_map(static fn (%s $v): string => $v->value, $value))", t.Name)
+				return t, true
+			}
+
+			t.Decode = t.Name + "::from($value)"
+			t.Encode = "$value->value"
+			return t, true
+		}
+	}
+
+	return t, false
+}
+
+// isMySQLSet reports whether col is a SET rather than an ENUM column. sqlc
+// creates a catalog enum for both, but the parser gives an ENUM the length of
+// its longest value and a SET the length of all values joined with commas.
+func isMySQLSet(col *plugin.Column, enum *plugin.Enum) bool {
+	if len(enum.Vals) < 2 {
+		return false
+	}
+
+	bytes, runes := len(enum.Vals)-1, len(enum.Vals)-1
+	for _, v := range enum.Vals {
+		bytes += len(v)
+		runes += utf8.RuneCountInString(v)
+	}
+
+	return int(col.Length) == bytes || int(col.Length) == runes
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestEnumCaseName(t *testing.T) {
+	tests := map[string]string{
+		"hardcover":   "Hardcover",
+		"e-book":      "EBook",
+		"IN_STOCK":    "InStock",
+		"1st edition": "Value1stEdition",
+		"":            "Empty",
+		"class":       "Class_",
+	}
+
+	for value, expected := range tests {
+		if got := enumCaseName(value); got != expected {
+			t.Errorf("enumCaseName(%q) = %q, want %q", value, got, expected)
+		}
+	}
+}
+
+func TestBuildEnums(t *testing.T) {
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{
+		DefaultSchema: "public",
+		Schemas: []*plugin.Schema{
+			{Name: "public", Enums: []*plugin.Enum{{Name: "book_type", Vals: []string{"a_b", "a-b", "it's"}}}},
+			{Name: "archive", Enums: []*plugin.Enum{{Name: "status", Vals: []string{"open"}}}},
+		},
+	}}
+
+	enums := BuildEnums(req)
+	if len(enums) != 2 || enums[0].Name != "ArchiveStatus" || enums[1].Name != "BookType" {
+		t.Fatalf("BuildEnums() = %+v", enums)
+	}
+
+	expected := []EnumCase{{"AB", "'a_b'"}, {"AB2", "'a-b'"}, {"ItS", `'it\'s'`}}
+	for i, c := range enums[1].Cases {
+		if c != expected[i] {
+			t.Errorf("Cases[%d] = %+v, want %+v", i, c, expected[i])
+		}
+	}
+}
+
+func TestEnumType(t *testing.T) {
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{
+		DefaultSchema: "public",
+		Schemas:       []*plugin.Schema{{Name: "public", Enums: []*plugin.Enum{{Name: "book_type", Vals: []string{"a"}}}}},
+	}}
+
+	col := &plugin.Column{Type: &plugin.Identifier{Name: "book_type"}}
+	typ, ok := enumType(req, col, phpType{Name: "mixed"})
+	if !ok || typ.Name != "BookType" || typ.Decode != "BookType::from($value)" || typ.Encode != "$value->value" {
+		t.Errorf("enumType() = %+v, %v", typ, ok)
+	}
+
+	col.Type.Name = "varchar"
+	if _, ok := enumType(req, col, phpType{Name: "string"}); ok {
+		t.Errorf("Expected no enum for a varchar column")
+	}
+}
+
+func TestIsMySQLSet(t *testing.T) {
+	enum := &plugin.Enum{Name: "post_tags", Vals: []string{"php", "go", "sql"}}
+	tests := []struct {
+		length   int32
+		expected bool
+	}{
+		{10, true},
+		{3, false},
+		{0, false},
+	}
+
+	for _, tt := range tests {
+		if got := isMySQLSet(&plugin.Column{Length: tt.length}, enum); got != tt.expected {
+			t.Errorf("isMySQLSet(Length: %d) = %v, want %v", tt.length, got, tt.expected)
+		}
+	}
+
+	single := &plugin.Enum{Name: "post_flag", Vals: []string{"pinned"}}
+	if isMySQLSet(&plugin.Column{Length: 6}, single) {
+		t.Errorf("Expected a single value to be treated as an ENUM")
+	}
+}
+
+func TestEnumType_Set(t *testing.T) {
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{
+		DefaultSchema: "public",
+		Schemas:       []*plugin.Schema{{Name: "public", Enums: []*plugin.Enum{{Name: "post_tags", Vals: []string{"php", "go"}}}}},
+	}}
+
+	col := &plugin.Column{Type: &plugin.Identifier{Name: "post_tags"}, Length: 6}
+	typ, ok := enumType(req, col, phpType{Name: "mixed", Engine: "mysql", IsNull: true})
+	if !ok || !typ.IsEnumList {
+		t.Fatalf("enumType() = %+v, %v", typ, ok)
+	}
+	if typ.String() != "?array" || typ.DocString() != "list<PostTags>|null" {
+		t.Errorf("String() = %q, DocString() = %q", typ.String(), typ.DocString())
+	}
+}
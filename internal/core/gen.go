@@ -1,9 +1,10 @@
 package core
 
 import (
-	"errors"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -74,39 +75,243 @@ func (v Params) ArgsWithDefaults() string {
 	return strings.Join(out, ", ")
 }
 
-func (v Params) Bindings() string {
+// Bindings returns the PDOStatement::bindValue calls for the method
+// parameters. Positions are literal unless a sqlc.slice() makes them depend on
+// the number of values, in which case they are counted in $i.
+func (v Params) Bindings() []string {
 	if v.isEmpty() {
-		return ""
+		return nil
 	}
 
-	var out []string
+	if !v.HasSlices() {
+		return v.bindValues("$", literalPosition)
+	}
+
+	out := []string{"$i = 1;"}
 	for _, f := range v.ModelClass.Fields {
-		if f.Type.IsJSON() {
-			out = append(out, fmt.Sprintf("json_encode($%s)", f.Name))
+		if f.Type.IsArray {
+			element := f.Type
+			element.IsArray, element.IsNull = false, false
+			out = append(out, fmt.Sprintf(
+				"foreach (array_values($%s) as $element) { %s }",
+				f.Name, bindValue("$i++", element, "$element"),
+			))
 			continue
 		}
 
-		if f.Type.IsBoolean() {
-			out = append(out, fmt.Sprintf("($%s ? 1 : 0)", f.Name))
+		out = append(out, bindValue("$i++", f.Type, "$"+f.Name))
+	}
+
+	return out
+}
+
+// ArgsBindings returns the bindValue calls for a generated Bindings object held
+// in $args, as used by the batch commands.
+func (v Params) ArgsBindings() []string {
+	return v.bindValues("$args->", literalPosition)
+}
+
+// CopyFromBindings returns the bindValue calls for the $n-th row of a
+// multi-row :copyfrom INSERT, read from a Bindings object held in $args.
+func (v Params) CopyFromBindings() []string {
+	out := []string{fmt.Sprintf("$offset = $n * %d;", len(v.ModelClass.Fields))}
+	return append(out, v.bindValues("$args->", func(i int) string {
+		return fmt.Sprintf("$offset + %d", i+1)
+	})...)
+}
+
+func (v Params) bindValues(prefix string, position func(i int) string) []string {
+	var out []string
+	for i, f := range v.ModelClass.Fields {
+		out = append(out, bindValue(position(i), f.Type, prefix+f.Name))
+	}
+
+	return out
+}
+
+func literalPosition(i int) string {
+	return strconv.Itoa(i + 1)
+}
+
+// bindValue binds the PHP expression v at the given position with the PDO
+// parameter type of t. Nullable values are checked at runtime so that NULL is
+// sent as PDO::PARAM_NULL.
+func bindValue(position string, t phpType, v string) string {
+	name, nullable := splitNullable(t.Name)
+	nullable = nullable || t.IsNull
+
+	value := v
+	switch {
+	case t.Encode != "":
+		value = expand(t.Encode, v)
+	case name == "array":
+		value = fmt.Sprintf("json_encode(%s)", v)
+	}
+
+	// Encoders never see NULL, which would otherwise be bound as e.g. the
+	// JSON string "null".
+	if value != v && nullable {
+		value = fmt.Sprintf("%s === null ? null : %s", v, value)
+	}
+
+	return fmt.Sprintf("$stmt->bindValue(%s, %s, %s);", position, value, pdoParamType(t, v))
+}
+
+// splitNullable splits a declared type such as "?array" or "array|null" into
+// the type without null and whether it admits null.
+func splitNullable(name string) (string, bool) {
+	if strings.HasPrefix(name, "?") {
+		return name[1:], true
+	}
+
+	var parts []string
+	nullable := false
+	for _, part := range strings.Split(name, "|") {
+		if strings.EqualFold(part, "null") {
+			nullable = true
 			continue
 		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, "|"), nullable
+}
+
+// pdoParamType returns the PDO::PARAM_* expression for a value of type t held
+// in v. Types given through @sqlc-param may be unions such as "bool|null".
+func pdoParamType(t phpType, v string) string {
+	name := t.Name
+	if t.EncodedType != "" {
+		name = t.EncodedType
+	}
+
+	name, nullable := splitNullable(name)
+	nullable = nullable || t.IsNull
+	parts := strings.Split(name, "|")
+
+	if len(parts) != 1 || parts[0] == "mixed" {
+		return unionParamType(parts, nullable, v)
+	}
+
+	param := "\\PDO::PARAM_STR"
+	switch {
+	case parts[0] == "int":
+		param = "\\PDO::PARAM_INT"
+	case parts[0] == "bool":
+		param = "\\PDO::PARAM_BOOL"
+	case parts[0] == "string" && t.IsBinary(), parts[0] == lobEncodedType:
+		param = "\\PDO::PARAM_LOB"
+	}
+
+	if nullable {
+		return fmt.Sprintf("%s === null ? \\PDO::PARAM_NULL : %s", v, param)
+	}
+
+	return param
+}
+
+// DocParams returns the PHPDoc @param values of the parameters whose type is
+// more precise than their declaration, e.g. "list<Tag> $tags".
+func (v Params) DocParams() []string {
+	if v.isEmpty() {
+		return nil
+	}
+
+	var out []string
+	for _, f := range v.ModelClass.Fields {
+		if f.DocType != "" {
+			out = append(out, f.DocType+" $"+f.Name)
+		}
+	}
+
+	return out
+}
+
+func fieldDocType(t phpType) string {
+	if !t.HasDocType() {
+		return ""
+	}
+
+	return t.DocString()
+}
+
+// unionParamType picks the PDO parameter type of a union type at runtime,
+// checking only for the members the union has.
+func unionParamType(parts []string, nullable bool, v string) string {
+	has := map[string]bool{}
+	for _, p := range parts {
+		has[p] = true
+	}
+
+	var conds, params []string
+	if nullable || has["mixed"] {
+		conds, params = append(conds, v+" === null"), append(params, "\\PDO::PARAM_NULL")
+	}
+	if has["int"] || has["mixed"] {
+		conds, params = append(conds, "is_int("+v+")"), append(params, "\\PDO::PARAM_INT")
+	}
+	if has["bool"] || has["mixed"] {
+		conds, params = append(conds, "is_bool("+v+")"), append(params, "\\PDO::PARAM_BOOL")
+	}
+
+	switch len(conds) {
+	case 0:
+		return "\\PDO::PARAM_STR"
+	case 1:
+		return fmt.Sprintf("%s ? %s : \\PDO::PARAM_STR", conds[0], params[0])
+	}
+
+	arms := make([]string, len(conds))
+	for i := range conds {
+		arms[i] = conds[i] + " => " + params[i]
+	}
 
-		out = append(out, fmt.Sprintf("$%s", f.Name))
+	return fmt.Sprintf("match (true) { %s, default => \\PDO::PARAM_STR }", strings.Join(arms, ", "))
+}
+
+func (v Params) HasSlices() bool {
+	for _, f := range v.ModelClass.Fields {
+		if f.Type.IsArray {
+			return true
+		}
 	}
 
-	return "[" + strings.Join(out, ", ") + "]"
+	return false
+}
+
+// rowColumn returns the PHP expression holding result column idx.
+func rowColumn(_ phpType, idx int) string {
+	return fmt.Sprintf(`$row[%d]`, idx)
+}
+
+// boundColumn returns the variable BindColumns binds result column idx to.
+func boundColumn(_ phpType, idx int) string {
+	return fmt.Sprintf(`$col%d`, idx)
 }
 
-func pdoRowMapping(t phpType, idx int) string {
+func pdoValueMapping(t phpType, v string) string {
+	if t.Decode != "" {
+		value := expand(t.Decode, v)
+		if t.IsNull {
+			return fmt.Sprintf("%s === null ? null : %s", v, value)
+		}
+
+		return value
+	}
+
 	if t.IsJSON() {
-		return fmt.Sprintf(`json_decode($row[%d], true) ?? []`, idx)
+		if t.IsNull {
+			return fmt.Sprintf(`%s === null ? null : json_decode(%s, true)`, v, v)
+		}
+
+		return fmt.Sprintf(`json_decode(%s, true) ?? []`, v)
 	}
 
 	if t.IsBoolean() {
-		return fmt.Sprintf(`(bool) $row[%d]`, idx)
+		return fmt.Sprintf(`(bool) %s`, v)
 	}
 
-	return fmt.Sprintf(`$row[%d]`, idx)
+	return v
 }
 
 func (v QueryValue) PDOFetchMode() string {
@@ -117,20 +322,85 @@ func (v QueryValue) PDOFetchMode() string {
 	return "\\PDO::FETCH_NUM"
 }
 
+// scalarRowMapping converts a single-column result held in v. Scalars are
+// cast, everything else is mapped like a column of a row, and NULL is kept
+// for nullable columns.
+func scalarRowMapping(t phpType, v string) string {
+	if t.Decode != "" {
+		return pdoValueMapping(t, v)
+	}
+
+	switch {
+	case t.IsInt(), t.IsFloat(), t.IsString(), t.IsBoolean():
+		value := fmt.Sprintf("(%s)(%s)", t.Name, v)
+		if t.IsNull {
+			return v + " === null ? null : " + value
+		}
+
+		return value
+	default:
+		return pdoValueMapping(t, v)
+	}
+}
+
 func (v QueryValue) ResultSet() string {
+	return v.resultSet(false)
+}
+
+// BoundResultSet is ResultSet for rows fetched with PDO::FETCH_BOUND after
+// BindColumns, reading every column from its bound variable.
+func (v QueryValue) BoundResultSet() string {
+	return v.resultSet(true)
+}
+
+func (v QueryValue) resultSet(bound bool) string {
+	column := rowColumn
+	if bound {
+		column = boundColumn
+	}
+
 	if !v.IsClass() {
-		return "$row"
+		if bound {
+			return scalarRowMapping(v.Typ, boundColumn(v.Typ, 0))
+		}
+
+		return scalarRowMapping(v.Typ, "$row")
 	}
 
 	var out []string
-	for idx, f := range v.Struct.Fields {
-		out = append(out, pdoRowMapping(f.Type, idx))
+	idx := 0
+	for _, f := range v.Struct.Fields {
+		if f.Embed != nil {
+			out = append(out, embedRowMapping(f, idx, column))
+			idx += len(f.Embed.Fields)
+			continue
+		}
+
+		out = append(out, pdoValueMapping(f.Type, column(f.Type, idx)))
+		idx++
 	}
 
 	ret := strings.Join(out, ", ")
 	return ret
 }
 
+// embedRowMapping hydrates a sqlc.embed() field from the columns of its table,
+// which start at offset. A nullable embed is null when all of them are NULL.
+func embedRowMapping(f Field, offset int, column func(phpType, int) string) string {
+	var args, nulls []string
+	for i, ef := range f.Embed.Fields {
+		args = append(args, pdoValueMapping(ef.Type, column(ef.Type, offset+i)))
+		nulls = append(nulls, fmt.Sprintf("$row[%d] === null", offset+i))
+	}
+
+	ret := fmt.Sprintf("new %s(%s)", f.Embed.Name, strings.Join(args, ", "))
+	if f.Type.IsNull {
+		ret = fmt.Sprintf("(%s ? null : %s)", strings.Join(nulls, " && "), ret)
+	}
+
+	return ret
+}
+
 func dataClassName(name string) string {
 	out := ""
 	for _, p := range strings.Split(name, "_") {
@@ -144,7 +414,7 @@ func memberName(name string) string {
 	return sdk.LowerTitle(dataClassName(name))
 }
 
-func BuildDataClasses(req *plugin.GenerateRequest) []*ModelClass {
+func BuildDataClasses(req *plugin.GenerateRequest, conf *Config) []*ModelClass {
 	var structs []*ModelClass
 	for _, schema := range req.Catalog.Schemas {
 		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
@@ -167,11 +437,13 @@ func BuildDataClasses(req *plugin.GenerateRequest) []*ModelClass {
 			}
 
 			for _, column := range table.Columns {
+				typ := makePhpTypeFromTableColumn(req, conf, column, &s.Table, column.Name)
 				s.Fields = append(s.Fields, Field{
 					OriginalColumnName: column.Name,
 					Name:               memberName(column.Name),
-					Type:               makePhpTypeFromSqlcColumn(req, column),
+					Type:               typ,
 					Comment:            column.Comment,
+					DocType:            fieldDocType(typ),
 				})
 			}
 			structs = append(structs, &s)
@@ -184,15 +456,74 @@ func BuildDataClasses(req *plugin.GenerateRequest) []*ModelClass {
 	return structs
 }
 
-func makePhpTypeFromSqlcColumn(req *plugin.GenerateRequest, col *plugin.Column) phpType {
-	typ := mapSqlColumnTypeToPhpType(req, col)
-	return phpType{
-		Name:     typ,
+func makePhpTypeFromSqlcColumn(req *plugin.GenerateRequest, conf *Config, col *plugin.Column) phpType {
+	return makePhpTypeFromTableColumn(req, conf, col, col.Table, col.OriginalName)
+}
+
+// makePhpTypeFromTableColumn maps col, which originates from the column name
+// of table, applying column overrides before database type overrides.
+func makePhpTypeFromTableColumn(req *plugin.GenerateRequest, conf *Config, col *plugin.Column, table *plugin.Identifier, name string) phpType {
+	t := phpType{
+		Name:     mapSqlColumnTypeToPhpType(req, col),
 		IsArray:  col.IsSqlcSlice,
 		IsNull:   !col.NotNull,
 		DataType: sdk.DataType(col.Type),
 		Engine:   req.Settings.Engine,
 	}
+
+	if ct, ok := converterType(conf, req.GetCatalog().GetDefaultSchema(), table, name, t); ok {
+		return ct
+	}
+
+	if et, ok := enumType(req, col, t); ok {
+		t = et
+	}
+
+	if dt, ok := dateTimeType(conf, t); ok {
+		t = dt
+	}
+
+	if dt, ok := decimalType(conf, t); ok {
+		t = dt
+	}
+
+	if bt, ok := unsignedBigIntType(conf, col, t); ok {
+		t = bt
+	}
+
+	if bt, ok := bitType(t); ok {
+		t = bt
+	}
+
+	if bt, ok := blobType(conf, t); ok {
+		t = bt
+	}
+
+	if jt, ok := jsonClassType(req, conf, col, table, name, t); ok {
+		t = jt
+	}
+
+	if jt, ok := jsonType(conf, col, t); ok {
+		t = jt
+	}
+
+	if ut, ok := uuidType(conf, req.GetCatalog().GetDefaultSchema(), table, name, t); ok {
+		t = ut
+	}
+
+	for _, o := range conf.Overrides {
+		if o.matchesColumn(t.Engine, req.GetCatalog().GetDefaultSchema(), table, name) {
+			return o.apply(t)
+		}
+	}
+
+	for _, o := range conf.Overrides {
+		if o.matches(t.Engine, t.DataType, t.IsNull) {
+			return o.apply(t)
+		}
+	}
+
+	return t
 }
 
 func mapSqlColumnTypeToPhpType(req *plugin.GenerateRequest, col *plugin.Column) string {
@@ -210,10 +541,134 @@ type goColumn struct {
 	id      int
 	docType string
 	defVal  string
+	embed   *goEmbed
 	*plugin.Column
 }
 
-func phpColumnsToStruct(req *plugin.GenerateRequest, name string, columns []goColumn, namer func(*plugin.Column, int) string) *ModelClass {
+// goEmbed is the table model a sqlc.embed() column is hydrated into.
+type goEmbed struct {
+	model    *ModelClass
+	nullable bool
+}
+
+var (
+	tableRefPattern = regexp.MustCompile(`(?i)\b(?:(FROM)|(?:(LEFT|RIGHT|FULL)(?:\s+OUTER)?\s+)?JOIN)\s+(\(\s*\)|[\w.` + "`" + `"]+)`)
+	aliasPattern    = regexp.MustCompile(`(?i)^\s+(?:AS\s+)?(\w+)`)
+	selectPattern   = regexp.MustCompile(`(?i)\bSELECT\b`)
+	fromPattern     = regexp.MustCompile(`(?i)\bFROM\b`)
+)
+
+// aliasKeywords may follow a table reference without being its alias.
+var aliasKeywords = map[string]bool{
+	"on": true, "using": true, "where": true, "join": true, "left": true, "right": true,
+	"full": true, "inner": true, "cross": true, "natural": true, "group": true,
+	"order": true, "limit": true, "having": true, "union": true, "returning": true,
+}
+
+// topLevelSQL blanks everything between parentheses, so subqueries and
+// function arguments do not count as part of the outer statement.
+func topLevelSQL(sql string) string {
+	b := []byte(sql)
+	depth := 0
+	for i, c := range b {
+		switch {
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case depth > 0:
+			b[i] = ' '
+		}
+	}
+
+	return string(b)
+}
+
+// outerJoinedTables returns the tables on the outer side of a LEFT, RIGHT or
+// FULL JOIN, keyed by their alias if they have one. Their embedded models may
+// be absent from a row.
+func outerJoinedTables(sql string) map[string]bool {
+	sql = topLevelSQL(sql)
+	out := map[string]bool{}
+	var seen []string
+	for _, m := range tableRefPattern.FindAllStringSubmatchIndex(sql, -1) {
+		var name string
+		if table := sql[m[6]:m[7]]; table[0] != '(' {
+			if i := strings.LastIndex(table, "."); i != -1 {
+				table = table[i+1:]
+			}
+			name = strings.ToLower(strings.Trim(table, "`\""))
+		}
+		if a := aliasPattern.FindStringSubmatch(sql[m[1]:]); a != nil && !aliasKeywords[strings.ToLower(a[1])] {
+			name = strings.ToLower(a[1])
+		}
+
+		var kind string
+		if m[4] != -1 {
+			kind = strings.ToUpper(sql[m[4]:m[5]])
+		}
+		// RIGHT and FULL joins make every table joined before them optional.
+		if kind == "RIGHT" || kind == "FULL" {
+			for _, prev := range seen {
+				out[prev] = true
+			}
+		}
+		if kind == "LEFT" || kind == "FULL" {
+			out[name] = true
+		}
+		seen = append(seen, name)
+	}
+
+	return out
+}
+
+// selectList splits the result columns of the outer SELECT. Embeds are
+// expanded by sqlc into one qualified column per model field.
+func selectList(sql string) []string {
+	sql = topLevelSQL(sql)
+	loc := selectPattern.FindStringIndex(sql)
+	if loc == nil {
+		return nil
+	}
+
+	list := sql[loc[1]:]
+	if from := fromPattern.FindStringIndex(list); from != nil {
+		list = list[:from[0]]
+	}
+
+	return strings.Split(list, ",")
+}
+
+// embedQualifier returns the table or alias that qualifies the first column
+// of an embed, falling back to the embedded table's name.
+func embedQualifier(selected []string, pos int, c *plugin.Column) string {
+	if pos < len(selected) {
+		if i := strings.LastIndex(selected[pos], "."); i != -1 {
+			fields := strings.Fields(selected[pos][:i])
+			if len(fields) > 0 {
+				q := fields[len(fields)-1]
+				if j := strings.LastIndex(q, "."); j != -1 {
+					q = q[j+1:]
+				}
+				return strings.ToLower(strings.Trim(q, "`\""))
+			}
+		}
+	}
+
+	return strings.ToLower(c.EmbedTable.Name)
+}
+
+func newGoEmbed(req *plugin.GenerateRequest, query *plugin.Query, c *plugin.Column, modelClasses []*ModelClass) (*goEmbed, error) {
+	for _, s := range modelClasses {
+		if sdk.SameTableName(c.EmbedTable, &s.Table, req.Catalog.DefaultSchema) {
+			return &goEmbed{model: s}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("query %s: no model found for sqlc.embed(%s)", query.Name, c.EmbedTable.Name)
+}
+
+func phpColumnsToStruct(req *plugin.GenerateRequest, conf *Config, name string, columns []goColumn, namer func(*plugin.Column, int) string) *ModelClass {
 	gs := ModelClass{Name: name}
 	idSeen := map[int]Field{}
 	nameSeen := map[string]int{}
@@ -231,17 +686,25 @@ func phpColumnsToStruct(req *plugin.GenerateRequest, name string, columns []goCo
 			OriginalColumnName: c.Column.Name,
 			ID:                 c.id,
 			Name:               fieldName,
-			Type:               makePhpTypeFromSqlcColumn(req, c.Column),
+		}
+
+		if c.embed != nil {
+			field.Type = phpType{Name: c.embed.model.Name, IsNull: c.embed.nullable}
+			field.Embed = c.embed.model
+		} else {
+			field.Type = makePhpTypeFromSqlcColumn(req, conf, c.Column)
 		}
 
 		if c.docType != "" {
-			field.Type = phpType{Name: c.docType}
+			field.Type = phpType{Name: c.docType, DataType: field.Type.DataType, Engine: field.Type.Engine}
 		}
 
 		if c.defVal != "" {
 			field.Default = c.defVal
 		}
 
+		field.DocType = fieldDocType(field.Type)
+
 		gs.Fields = append(gs.Fields, field)
 		nameSeen[c.Name]++
 		idSeen[c.id] = field
@@ -266,7 +729,61 @@ func phpColumnName(c *plugin.Column, pos int) string {
 	return fmt.Sprintf("column_%d", pos+1)
 }
 
-func BuildQueries(req *plugin.GenerateRequest, modelClasses []*ModelClass) ([]Query, []*ModelClass, error) {
+var supportedCommands = map[string]bool{
+	metadata.CmdOne:        true,
+	metadata.CmdMany:       true,
+	metadata.CmdExec:       true,
+	metadata.CmdExecRows:   true,
+	metadata.CmdExecResult: true,
+	metadata.CmdExecLastId: true,
+	metadata.CmdCopyFrom:   true,
+	metadata.CmdBatchExec:  true,
+	metadata.CmdBatchOne:   true,
+	metadata.CmdBatchMany:  true,
+}
+
+func isBatchCommand(cmd string) bool {
+	return cmd == metadata.CmdBatchExec || cmd == metadata.CmdBatchOne || cmd == metadata.CmdBatchMany
+}
+
+// maxPlaceholders is the number of bound parameters a single statement may
+// use, which bounds how many rows a :copyfrom chunk can insert at once.
+var maxPlaceholders = map[string]int{
+	"sqlite": 32766,
+	"mysql":  65535,
+}
+
+// copyFromInsert rewrites a :copyfrom query into the "INSERT INTO ... VALUES"
+// prefix that the generated code completes with one placeholder group per row.
+func copyFromInsert(req *plugin.GenerateRequest, query *plugin.Query, params *ModelClass) (string, error) {
+	if query.InsertIntoTable == nil || len(params.Fields) == 0 {
+		return "", fmt.Errorf("query %s: :copyfrom requires an INSERT statement with parameters", query.Name)
+	}
+
+	table := query.InsertIntoTable.Name
+	if schema := query.InsertIntoTable.Schema; schema != "" && schema != req.Catalog.DefaultSchema {
+		table = schema + "." + table
+	}
+
+	// Params renamed with sqlc.arg() keep the table column in OriginalName.
+	names := map[int]string{}
+	for _, p := range query.Params {
+		name := p.Column.OriginalName
+		if name == "" {
+			name = p.Column.Name
+		}
+		names[int(p.Number)] = name
+	}
+
+	columns := make([]string, 0, len(params.Fields))
+	for _, f := range params.Fields {
+		columns = append(columns, names[f.ID])
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES", table, strings.Join(columns, ", ")), nil
+}
+
+func BuildQueries(req *plugin.GenerateRequest, conf *Config, modelClasses []*ModelClass) ([]Query, []*ModelClass, error) {
 	queries := make([]Query, 0, len(req.Queries))
 	emitModelClasses := make([]*ModelClass, 0)
 
@@ -275,8 +792,8 @@ func BuildQueries(req *plugin.GenerateRequest, modelClasses []*ModelClass) ([]Qu
 			continue
 		}
 
-		if query.Cmd == metadata.CmdCopyFrom {
-			return nil, nil, errors.New("support for CopyFrom in PHP is not implemented")
+		if !supportedCommands[query.Cmd] {
+			return nil, nil, fmt.Errorf("query %s: unsupported command %s", query.Name, query.Cmd)
 		}
 
 		queryString := query.Text
@@ -308,27 +825,60 @@ func BuildQueries(req *plugin.GenerateRequest, modelClasses []*ModelClass) ([]Qu
 			})
 		}
 
-		params := phpColumnsToStruct(req, queryStruct.ClassName+"Bindings", cols, phpParamName)
+		params := phpColumnsToStruct(req, conf, queryStruct.ClassName+"Bindings", cols, phpParamName)
 		queryStruct.Arg = Params{ModelClass: params}
 
-		if len(query.Columns) == 1 {
+		if query.Cmd == metadata.CmdCopyFrom {
+			insert, err := copyFromInsert(req, query, params)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			limit, ok := maxPlaceholders[req.Settings.Engine]
+			if !ok {
+				limit = maxPlaceholders["mysql"]
+			}
+
+			queryStruct.SQL = insert
+			queryStruct.CopyFromRowsPerChunk = limit / len(params.Fields)
+			queryStruct.CopyFromRow = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(params.Fields)), ", ") + ")"
+			emitModelClasses = append(emitModelClasses, params)
+		}
+
+		if isBatchCommand(query.Cmd) {
+			if queryStruct.Arg.HasSlices() {
+				return nil, nil, fmt.Errorf("query %s: sqlc.slice() cannot be used with %s", query.Name, query.Cmd)
+			}
+
+			emitModelClasses = append(emitModelClasses, params)
+		}
+
+		hasEmbeds := false
+		for _, c := range query.Columns {
+			if c.EmbedTable != nil {
+				hasEmbeds = true
+				break
+			}
+		}
+
+		if len(query.Columns) == 1 && !hasEmbeds {
 			c := query.Columns[0]
 			queryStruct.Ret = QueryValue{
 				Name: "results",
-				Typ:  makePhpTypeFromSqlcColumn(req, c),
+				Typ:  makePhpTypeFromSqlcColumn(req, conf, c),
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) > 0 {
 			var gs *ModelClass
 
 			for _, s := range modelClasses {
-				if len(s.Fields) != len(query.Columns) {
+				if hasEmbeds || len(s.Fields) != len(query.Columns) {
 					continue
 				}
 
 				same := true
 				for i, f := range s.Fields {
 					c := query.Columns[i]
-					if f.Name != memberName(phpColumnName(c, i)) || f.Type != makePhpTypeFromSqlcColumn(req, c) || !sdk.SameTableName(c.Table, &s.Table, req.Catalog.DefaultSchema) {
+					if f.Name != memberName(phpColumnName(c, i)) || f.Type != makePhpTypeFromSqlcColumn(req, conf, c) || !sdk.SameTableName(c.Table, &s.Table, req.Catalog.DefaultSchema) {
 						same = false
 						break
 					}
@@ -342,10 +892,27 @@ func BuildQueries(req *plugin.GenerateRequest, modelClasses []*ModelClass) ([]Qu
 
 			if gs == nil {
 				var columns []goColumn
+				outer := outerJoinedTables(query.Text)
+				selected := selectList(query.Text)
+				pos := 0
 				for i, c := range query.Columns {
-					columns = append(columns, goColumn{id: i, Column: c})
+					column := goColumn{id: i, Column: c}
+					if c.EmbedTable != nil {
+						embed, err := newGoEmbed(req, query, c, modelClasses)
+						if err != nil {
+							return nil, nil, err
+						}
+
+						embed.nullable = outer[embedQualifier(selected, pos, c)]
+						column.embed = embed
+						pos += len(embed.model.Fields)
+					} else {
+						pos++
+					}
+
+					columns = append(columns, column)
 				}
-				gs = phpColumnsToStruct(req, queryStruct.ClassName+"Row", columns, phpColumnName)
+				gs = phpColumnsToStruct(req, conf, queryStruct.ClassName+"Row", columns, phpColumnName)
 				emitModelClasses = append(emitModelClasses, gs)
 			}
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
@@ -31,8 +32,8 @@ func TestParams_Args(t *testing.T) {
 func TestParams_Bindings(t *testing.T) {
 	mc := &ModelClass{Fields: []Field{{Name: "foo", Type: phpType{Name: "int"}}}}
 	p := Params{ModelClass: mc}
-	expected := "[$foo]"
-	if got := p.Bindings(); got != expected {
+	expected := []string{"$stmt->bindValue(1, $foo, \\PDO::PARAM_INT);"}
+	if got := p.Bindings(); !reflect.DeepEqual(got, expected) {
 		t.Errorf("Bindings() = %q, want %q", got, expected)
 	}
 }
@@ -79,12 +80,52 @@ func TestPhpColumnName(t *testing.T) {
 func TestMakePhpTypeFromSqlcColumn(t *testing.T) {
 	req := &plugin.GenerateRequest{Settings: &plugin.Settings{Engine: "sqlite"}}
 	col := &plugin.Column{Type: &plugin.Identifier{Name: "INTEGER"}, NotNull: true}
-	typ := makePhpTypeFromSqlcColumn(req, col)
+	typ := makePhpTypeFromSqlcColumn(req, &Config{}, col)
 	if typ.Name == "" {
 		t.Errorf("Expected non-empty type name")
 	}
 }
 
+func TestMakePhpTypeFromSqlcColumn_Overrides(t *testing.T) {
+	req := &plugin.GenerateRequest{Settings: &plugin.Settings{Engine: "mysql"}}
+	conf := &Config{Overrides: []Override{
+		{DBType: "decimal", Engine: "sqlite", PHPType: "float"},
+		{DBType: "DECIMAL", PHPType: `Brick\Math\BigDecimal`, Decode: `\Brick\Math\BigDecimal::of($value)`},
+	}}
+
+	col := &plugin.Column{Type: &plugin.Identifier{Name: "decimal"}, NotNull: true}
+	typ := makePhpTypeFromSqlcColumn(req, conf, col)
+	if typ.Name != `\Brick\Math\BigDecimal` || typ.Decode == "" {
+		t.Errorf("Expected the mysql override to apply, got %+v", typ)
+	}
+
+	col.NotNull = false
+	if typ := makePhpTypeFromSqlcColumn(req, conf, col); typ.Name != "string" {
+		t.Errorf("Expected a non-nullable override to skip nullable columns, got %+v", typ)
+	}
+}
+
+func TestMakePhpTypeFromSqlcColumn_SetOverride(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: "mysql"},
+		Catalog: &plugin.Catalog{
+			DefaultSchema: "public",
+			Schemas:       []*plugin.Schema{{Name: "public", Enums: []*plugin.Enum{{Name: "post_tags", Vals: []string{"php", "go"}}}}},
+		},
+	}
+	col := &plugin.Column{Name: "tags", OriginalName: "tags", Table: &plugin.Identifier{Name: "post"}, Type: &plugin.Identifier{Name: "post_tags"}, Length: 6, NotNull: true}
+
+	for _, o := range []Override{
+		{Column: "post.tags", PHPType: `App\Tags`, Decode: `new \App\Tags($value)`},
+		{DBType: "post_tags", PHPType: `App\Tags`, Decode: `new \App\Tags($value)`},
+	} {
+		typ := makePhpTypeFromSqlcColumn(req, &Config{Overrides: []Override{o}}, col)
+		if typ.IsEnumList || typ.String() != `\App\Tags` || typ.DocString() != `\App\Tags` {
+			t.Errorf("Expected %+v to replace the SET list, got %+v", o, typ)
+		}
+	}
+}
+
 func TestMapSqlColumnTypeToPhpType(t *testing.T) {
 	req := &plugin.GenerateRequest{Settings: &plugin.Settings{Engine: "sqlite"}}
 	col := &plugin.Column{Type: &plugin.Identifier{Name: "INTEGER"}}
@@ -106,7 +147,7 @@ func TestPhpColumnsToStruct(t *testing.T) {
 		},
 	}
 
-	mc := phpColumnsToStruct(req, "TestStruct", columns, func(c *plugin.Column, i int) string { return c.Name })
+	mc := phpColumnsToStruct(req, &Config{}, "TestStruct", columns, func(c *plugin.Column, i int) string { return c.Name })
 	if mc.Name != "TestStruct" {
 		t.Errorf("phpColumnsToStruct.Name = %q", mc.Name)
 	}
@@ -115,3 +156,367 @@ func TestPhpColumnsToStruct(t *testing.T) {
 		t.Errorf("phpColumnsToStruct.Fields = %+v", mc.Fields)
 	}
 }
+
+func TestParams_Bindings_Slice(t *testing.T) {
+	mc := &ModelClass{Fields: []Field{
+		{Name: "authorId", Type: phpType{Name: "int"}},
+		{Name: "ids", Type: phpType{Name: "int", IsArray: true}},
+		{Name: "flags", Type: phpType{Name: "bool", IsArray: true}},
+	}}
+	p := Params{ModelClass: mc}
+	expected := []string{
+		"$i = 1;",
+		"$stmt->bindValue($i++, $authorId, \\PDO::PARAM_INT);",
+		"foreach (array_values($ids) as $element) { $stmt->bindValue($i++, $element, \\PDO::PARAM_INT); }",
+		"foreach (array_values($flags) as $element) { $stmt->bindValue($i++, $element, \\PDO::PARAM_BOOL); }",
+	}
+	if got := p.Bindings(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Bindings() = %q, want %q", got, expected)
+	}
+
+	if !p.HasSlices() {
+		t.Errorf("Expected HasSlices to be true")
+	}
+}
+
+func TestParams_CopyFromBindings(t *testing.T) {
+	mc := &ModelClass{Fields: []Field{
+		{Name: "name", Type: phpType{Name: "string"}},
+		{Name: "bio", Type: phpType{Name: "string", IsNull: true}},
+	}}
+	expected := []string{
+		"$offset = $n * 2;",
+		"$stmt->bindValue($offset + 1, $args->name, \\PDO::PARAM_STR);",
+		"$stmt->bindValue($offset + 2, $args->bio, $args->bio === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);",
+	}
+	if got := (Params{ModelClass: mc}).CopyFromBindings(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("CopyFromBindings() = %q, want %q", got, expected)
+	}
+}
+
+func TestPdoParamType(t *testing.T) {
+	tests := []struct {
+		typ      phpType
+		expected string
+	}{
+		{phpType{Name: "int"}, "\\PDO::PARAM_INT"},
+		{phpType{Name: "bool"}, "\\PDO::PARAM_BOOL"},
+		{phpType{Name: "float"}, "\\PDO::PARAM_STR"},
+		{phpType{Name: "string", DataType: "LONGBLOB"}, "\\PDO::PARAM_LOB"},
+		{phpType{Name: "int", IsNull: true}, "$v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_INT"},
+		{phpType{Name: "bool|null"}, "$v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_BOOL"},
+		{phpType{Name: "?string"}, "$v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR"},
+		{phpType{Name: "int|string"}, "is_int($v) ? \\PDO::PARAM_INT : \\PDO::PARAM_STR"},
+		{phpType{Name: "int|string", IsNull: true}, "match (true) { $v === null => \\PDO::PARAM_NULL, is_int($v) => \\PDO::PARAM_INT, default => \\PDO::PARAM_STR }"},
+		{phpType{Name: "mixed", IsNull: true}, "match (true) { $v === null => \\PDO::PARAM_NULL, is_int($v) => \\PDO::PARAM_INT, is_bool($v) => \\PDO::PARAM_BOOL, default => \\PDO::PARAM_STR }"},
+	}
+
+	for _, tt := range tests {
+		if got := pdoParamType(tt.typ, "$v"); got != tt.expected {
+			t.Errorf("pdoParamType(%+v) = %q, want %q", tt.typ, got, tt.expected)
+		}
+	}
+}
+
+func TestBindValue_Encode(t *testing.T) {
+	typ := phpType{Name: `\Money`, IsNull: true, Encode: "$value->amount()"}
+	expected := "$stmt->bindValue(1, $price === null ? null : $price->amount(), $price === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);"
+	if got := bindValue("1", typ, "$price"); got != expected {
+		t.Errorf("bindValue() = %q, want %q", got, expected)
+	}
+}
+
+func TestBindValue_Nullable(t *testing.T) {
+	cases := []struct {
+		typ      phpType
+		expected string
+	}{
+		{phpType{Name: "bool", IsNull: true}, "$stmt->bindValue(1, $v, $v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_BOOL);"},
+		{phpType{Name: "bool|null"}, "$stmt->bindValue(1, $v, $v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_BOOL);"},
+		{phpType{Name: "array"}, "$stmt->bindValue(1, json_encode($v), \\PDO::PARAM_STR);"},
+		{phpType{Name: "array", IsNull: true}, "$stmt->bindValue(1, $v === null ? null : json_encode($v), $v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);"},
+		{phpType{Name: "?array"}, "$stmt->bindValue(1, $v === null ? null : json_encode($v), $v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);"},
+		{phpType{Name: "array|null"}, "$stmt->bindValue(1, $v === null ? null : json_encode($v), $v === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_STR);"},
+	}
+
+	for _, tc := range cases {
+		if got := bindValue("1", tc.typ, "$v"); got != tc.expected {
+			t.Errorf("bindValue(%+v) = %q, want %q", tc.typ, got, tc.expected)
+		}
+	}
+}
+
+func TestSplitNullable(t *testing.T) {
+	cases := []struct {
+		name     string
+		typ      string
+		nullable bool
+	}{
+		{"int", "int", false},
+		{"?int", "int", true},
+		{"int|null", "int", true},
+		{"int|string|NULL", "int|string", true},
+		{"mixed", "mixed", false},
+	}
+
+	for _, tc := range cases {
+		if typ, nullable := splitNullable(tc.name); typ != tc.typ || nullable != tc.nullable {
+			t.Errorf("splitNullable(%q) = %q, %v", tc.name, typ, nullable)
+		}
+	}
+}
+
+func TestPdoValueMapping_Decode(t *testing.T) {
+	typ := phpType{Name: `\Money`, Decode: "\\Money::of($value)"}
+	if got := pdoValueMapping(typ, "$row[1]"); got != "\\Money::of($row[1])" {
+		t.Errorf("pdoValueMapping() = %q", got)
+	}
+
+	typ.IsNull = true
+	if got := pdoValueMapping(typ, "$row[1]"); got != "$row[1] === null ? null : \\Money::of($row[1])" {
+		t.Errorf("pdoValueMapping() = %q", got)
+	}
+}
+
+func TestQuery_PrepareSQL(t *testing.T) {
+	q := Query{ConstantName: "listBooks", Arg: Params{ModelClass: &ModelClass{}}}
+	if got := q.PrepareSQL(); got != "listBooks" {
+		t.Errorf("PrepareSQL() = %q", got)
+	}
+
+	q.Arg.ModelClass.Fields = []Field{
+		{Name: "ids", OriginalColumnName: "ids", Type: phpType{Name: "int", IsArray: true}},
+		{Name: "bookTitles", OriginalColumnName: "book_titles", Type: phpType{Name: "string", IsArray: true}},
+	}
+	expected := "self::expandSlice(self::expandSlice(listBooks, 'ids', $ids), 'book_titles', $bookTitles)"
+	if got := q.PrepareSQL(); got != expected {
+		t.Errorf("PrepareSQL() = %q, want %q", got, expected)
+	}
+}
+
+func TestBuildQueries_Commands(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: "sqlite"},
+		Catalog:  &plugin.Catalog{},
+		Queries:  []*plugin.Query{{Name: "CreateAuthor", Cmd: ":execlastid", Text: "INSERT INTO author DEFAULT VALUES"}},
+	}
+
+	queries, _, err := BuildQueries(req, &Config{}, nil)
+	if err != nil {
+		t.Fatalf("BuildQueries() error = %v", err)
+	}
+
+	if len(queries) != 1 || queries[0].Cmd != ":execlastid" {
+		t.Errorf("BuildQueries() = %+v", queries)
+	}
+
+	req.Queries[0].Cmd = ":unknown"
+	if _, _, err := BuildQueries(req, &Config{}, nil); err == nil {
+		t.Errorf("Expected an error for an unsupported command")
+	}
+}
+
+func TestBuildQueries_CopyFrom(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: "mysql"},
+		Catalog:  &plugin.Catalog{DefaultSchema: "public"},
+		Queries: []*plugin.Query{{
+			Name:            "CreateAuthors",
+			Cmd:             ":copyfrom",
+			Text:            "INSERT INTO author (name, bio) VALUES (?, ?)",
+			InsertIntoTable: &plugin.Identifier{Name: "author"},
+			Params: []*plugin.Parameter{
+				{Number: 1, Column: &plugin.Column{Name: "name", Type: &plugin.Identifier{Name: "text"}, NotNull: true}},
+				{Number: 2, Column: &plugin.Column{Name: "bio", Type: &plugin.Identifier{Name: "text"}}},
+			},
+		}},
+	}
+
+	queries, emit, err := BuildQueries(req, &Config{}, nil)
+	if err != nil {
+		t.Fatalf("BuildQueries() error = %v", err)
+	}
+
+	q := queries[0]
+	if q.SQL != "INSERT INTO author (name, bio) VALUES" {
+		t.Errorf("SQL = %q", q.SQL)
+	}
+
+	if q.CopyFromRow != "(?, ?)" || q.CopyFromRowsPerChunk != 32767 {
+		t.Errorf("CopyFromRow = %q, CopyFromRowsPerChunk = %d", q.CopyFromRow, q.CopyFromRowsPerChunk)
+	}
+
+	if len(emit) != 1 || emit[0].Name != "CreateAuthorsBindings" {
+		t.Errorf("Expected the bindings class to be emitted, got %+v", emit)
+	}
+
+	// sqlc.arg() renames the params but not the inserted columns.
+	req.Queries[0].Params[0].Column = &plugin.Column{Name: "first", OriginalName: "name", Type: &plugin.Identifier{Name: "text"}, NotNull: true}
+	req.Queries[0].Params[1].Column = &plugin.Column{Name: "second", OriginalName: "bio", Type: &plugin.Identifier{Name: "text"}}
+	queries, _, err = BuildQueries(req, &Config{}, nil)
+	if err != nil {
+		t.Fatalf("BuildQueries() error = %v", err)
+	}
+	if queries[0].SQL != "INSERT INTO author (name, bio) VALUES" {
+		t.Errorf("SQL with aliased args = %q", queries[0].SQL)
+	}
+	if f := queries[0].Arg.ModelClass.Fields; f[0].Name != "first" || f[1].Name != "second" {
+		t.Errorf("Expected the bindings to keep the arg names, got %+v", f)
+	}
+
+	req.Queries[0].InsertIntoTable = nil
+	if _, _, err := BuildQueries(req, &Config{}, nil); err == nil {
+		t.Errorf("Expected an error for :copyfrom without a target table")
+	}
+}
+
+func TestBuildQueries_Batch(t *testing.T) {
+	id := &plugin.Column{Name: "id", Type: &plugin.Identifier{Name: "INTEGER"}, NotNull: true}
+	req := &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: "sqlite"},
+		Catalog:  &plugin.Catalog{},
+		Queries: []*plugin.Query{{
+			Name:   "DeleteBook",
+			Cmd:    ":batchexec",
+			Text:   "DELETE FROM book WHERE book_id = ?",
+			Params: []*plugin.Parameter{{Number: 1, Column: id}},
+		}},
+	}
+
+	_, emit, err := BuildQueries(req, &Config{}, nil)
+	if err != nil {
+		t.Fatalf("BuildQueries() error = %v", err)
+	}
+
+	if len(emit) != 1 || emit[0].Name != "DeleteBookBindings" {
+		t.Errorf("Expected the bindings class to be emitted, got %+v", emit)
+	}
+
+	id.IsSqlcSlice = true
+	if _, _, err := BuildQueries(req, &Config{}, nil); err == nil {
+		t.Errorf("Expected an error for sqlc.slice() in a batch query")
+	}
+}
+
+func TestOuterJoinedTables(t *testing.T) {
+	cases := []struct {
+		name  string
+		sql   string
+		outer []string
+		inner []string
+	}{
+		{"left", "SELECT * FROM book JOIN shelf ON 1 LEFT OUTER JOIN author a ON a.id = book.author_id LEFT JOIN `main`.`tag` ON 1", []string{"a", "tag"}, []string{"book", "shelf", "author"}},
+		{"right", "SELECT * FROM book b JOIN shelf ON 1 RIGHT JOIN author ON author.id = b.author_id", []string{"b", "shelf"}, []string{"author", "book"}},
+		{"full", "SELECT * FROM book FULL OUTER JOIN author AS a ON a.id = book.author_id", []string{"book", "a"}, nil},
+		{"self join", "SELECT * FROM employee e LEFT JOIN employee m ON m.id = e.manager_id", []string{"m"}, []string{"e", "employee"}},
+		{"subquery", "SELECT * FROM book JOIN (SELECT s.id FROM shelf s LEFT JOIN author ON 1) x ON x.id = book.shelf_id WHERE book.id IN (SELECT id FROM tag LEFT JOIN book ON 1)", nil, []string{"book", "x", "s", "author"}},
+		{"derived table", "SELECT * FROM (SELECT id FROM book) b RIGHT JOIN author ON 1", []string{"b"}, []string{"author"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := outerJoinedTables(tc.sql)
+			for _, name := range tc.outer {
+				if !got[name] {
+					t.Errorf("outerJoinedTables() is missing %q: %v", name, got)
+				}
+			}
+			for _, name := range tc.inner {
+				if got[name] {
+					t.Errorf("outerJoinedTables() contains %q: %v", name, got)
+				}
+			}
+		})
+	}
+}
+
+func TestEmbedQualifier(t *testing.T) {
+	employee := &plugin.Column{Name: "employee", EmbedTable: &plugin.Identifier{Name: "employee"}}
+	selected := selectList("SELECT e.id, e.name, `m`.id, `m`.name, COUNT(*) FROM employee e LEFT JOIN employee m ON m.id = e.manager_id")
+	if len(selected) != 5 {
+		t.Fatalf("selectList() = %q", selected)
+	}
+
+	for pos, want := range map[int]string{0: "e", 2: "m", 4: "employee", 9: "employee"} {
+		if got := embedQualifier(selected, pos, employee); got != want {
+			t.Errorf("embedQualifier(%d) = %q, want %q", pos, got, want)
+		}
+	}
+}
+
+func TestBuildQueries_SelfJoinEmbed(t *testing.T) {
+	employee := &ModelClass{Name: "Employee", Table: plugin.Identifier{Schema: "main", Name: "employee"}, Fields: []Field{
+		{Name: "id", Type: phpType{Name: "int"}},
+		{Name: "managerId", Type: phpType{Name: "int", IsNull: true}},
+	}}
+	// sqlc names both embeds after the resolved table, not the alias.
+	embed := func() *plugin.Column {
+		return &plugin.Column{Name: "employee", EmbedTable: &plugin.Identifier{Name: "employee"}}
+	}
+	req := &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: "sqlite"},
+		Catalog:  &plugin.Catalog{DefaultSchema: "main"},
+		Queries: []*plugin.Query{{
+			Name:    "ListEmployeesWithManager",
+			Cmd:     ":many",
+			Text:    "SELECT e.id, e.manager_id, m.id, m.manager_id FROM employee e LEFT JOIN employee m ON m.id = e.manager_id",
+			Columns: []*plugin.Column{embed(), embed()},
+		}},
+	}
+
+	queries, _, err := BuildQueries(req, &Config{}, []*ModelClass{employee})
+	if err != nil {
+		t.Fatalf("BuildQueries() error = %v", err)
+	}
+
+	fields := queries[0].Ret.Struct.Fields
+	if fields[0].Type.IsNull || !fields[1].Type.IsNull {
+		t.Errorf("Expected only the LEFT joined embed to be nullable, got %+v", fields)
+	}
+}
+
+func TestQueryValue_ResultSet_Embed(t *testing.T) {
+	author := &ModelClass{Name: "Author", Fields: []Field{
+		{Name: "id", Type: phpType{Name: "int"}},
+		{Name: "data", Type: phpType{Name: "array"}},
+	}}
+	qv := QueryValue{Struct: &ModelClass{Name: "Row", Fields: []Field{
+		{Name: "title", Type: phpType{Name: "string"}},
+		{Name: "author", Type: phpType{Name: "Author", IsNull: true}, Embed: author},
+		{Name: "flag", Type: phpType{Name: "bool"}},
+	}}}
+
+	expected := "$row[0], ($row[1] === null && $row[2] === null ? null : new Author($row[1], json_decode($row[2], true) ?? [])), (bool) $row[3]"
+	if got := qv.ResultSet(); got != expected {
+		t.Errorf("ResultSet() = %q, want %q", got, expected)
+	}
+}
+
+func TestQueryValue_ResultSet_Scalar(t *testing.T) {
+	qv := QueryValue{Typ: phpType{Name: "int"}}
+	if got := qv.ResultSet(); got != "(int)($row)" {
+		t.Errorf("ResultSet() = %q", got)
+	}
+
+	qv.Typ.IsNull = true
+	if got := qv.ResultSet(); got != "$row === null ? null : (int)($row)" {
+		t.Errorf("ResultSet() = %q", got)
+	}
+}
+
+func TestQueryValue_ResultSet_SingleColumn(t *testing.T) {
+	cases := []struct {
+		typ      phpType
+		expected string
+	}{
+		{phpType{Name: "array"}, "json_decode($row, true) ?? []"},
+		{phpType{Name: "array", IsNull: true}, "$row === null ? null : json_decode($row, true)"},
+		{phpType{Name: "mixed", IsNull: true}, "$row"},
+	}
+
+	for _, tc := range cases {
+		qv := QueryValue{Typ: tc.typ}
+		if got := qv.ResultSet(); got != tc.expected {
+			t.Errorf("ResultSet() (%+v) = %q, want %q", tc.typ, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+const (
+	jsonDecodeAssoc  = "assoc"
+	jsonDecodeObject = "object"
+
+	// decodeJsonHelper is the generated helper that decodes with
+	// JSON_THROW_ON_ERROR and names the query and column on failure.
+	decodeJsonHelper = "self::decodeJson("
+
+	// jsonArrayHelper is the generated helper that rejects JSON values a
+	// class's fromArray() factory cannot take.
+	jsonArrayHelper = "self::jsonArray("
+)
+
+var jsonFlag = regexp.MustCompile(`^JSON_[A-Z_]+$`)
+
+// jsonClassAnnotation attaches a class to a JSON column through its comment,
+// e.g. COMMENT '@sqlc-json App\AuthorProfile'.
+var jsonClassAnnotation = regexp.MustCompile(`@sqlc-json\s+\\?([A-Za-z_\\][A-Za-z0-9_\\]*)`)
+
+// jsonClassType maps a JSON column to the class configured for it in the
+// json_classes option or annotated in its comment. Values are hydrated with
+// the static Class::fromArray() factory and bound through json_encode(), so
+// the class implements \JsonSerializable to control its encoding. JSON that
+// does not decode to an object or array is rejected before fromArray().
+func jsonClassType(req *plugin.GenerateRequest, conf *Config, col *plugin.Column, table *plugin.Identifier, name string, t phpType) (phpType, bool) {
+	if !t.IsJSON() {
+		return t, false
+	}
+
+	columns := make([]string, 0, len(conf.JSONClasses))
+	for column := range conf.JSONClasses {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	class := ""
+	for _, column := range columns {
+		if (Override{Column: column}).matchesColumn(t.Engine, req.GetCatalog().GetDefaultSchema(), table, name) {
+			class = conf.JSONClasses[column]
+			break
+		}
+	}
+	if class == "" {
+		if m := jsonClassAnnotation.FindStringSubmatch(columnComment(req, col, table, name)); m != nil {
+			class = m[1]
+		}
+	}
+	if class == "" {
+		return t, false
+	}
+
+	t.Name = qualifiedClass(class)
+	t.Decode = fmt.Sprintf("%s::fromArray(%s%s, __FUNCTION__, %s))", t.Name, jsonArrayHelper, jsonDecodeExpr(conf, true, col.Name), phpStringLiteral(col.Name))
+	t.Encode = jsonEncodeExpr(conf)
+	t.EncodedType = "string"
+	return t, true
+}
+
+// jsonType applies the json_decode, json_throw_on_error and JSON flags
+// options to a JSON column. Without them, JSON columns are decoded into
+// associative arrays by pdoValueMapping.
+func jsonType(conf *Config, col *plugin.Column, t phpType) (phpType, bool) {
+	if !t.IsJSON() || t.IsArray || !conf.hasJSONCodec() {
+		return t, false
+	}
+
+	assoc := conf.JSONDecode != jsonDecodeObject
+	if !assoc {
+		// Only JSON objects become \stdClass, JSON arrays stay arrays.
+		t.Name = "array|\\stdClass"
+	}
+
+	t.Decode = jsonDecodeExpr(conf, assoc, col.Name)
+	if assoc && !conf.JSONThrowOnError && !t.IsNull {
+		t.Decode += " ?? []"
+	}
+	t.Encode = jsonEncodeExpr(conf)
+	t.EncodedType = "string"
+	return t, true
+}
+
+func (c *Config) hasJSONCodec() bool {
+	return c.JSONDecode != "" || c.JSONThrowOnError || len(c.JSONDecodeFlags) > 0 || len(c.JSONEncodeFlags) > 0
+}
+
+// jsonDecodeExpr returns the PHP expression decoding the JSON $value of
+// column. With json_throw_on_error the query is named by __FUNCTION__, the
+// generated method hydrating the row.
+func jsonDecodeExpr(conf *Config, assoc bool, column string) string {
+	if conf.JSONThrowOnError {
+		return fmt.Sprintf("%s$value, %t, __FUNCTION__, %s)", decodeJsonHelper, assoc, phpStringLiteral(column))
+	}
+
+	if len(conf.JSONDecodeFlags) > 0 {
+		return fmt.Sprintf("json_decode($value, %t, 512, %s)", assoc, strings.Join(conf.JSONDecodeFlags, " | "))
+	}
+
+	return fmt.Sprintf("json_decode($value, %t)", assoc)
+}
+
+// jsonEncodeExpr returns the PHP expression encoding $value as JSON.
+func jsonEncodeExpr(conf *Config) string {
+	var flags []string
+	if conf.JSONThrowOnError {
+		flags = append(flags, "JSON_THROW_ON_ERROR")
+	}
+	flags = append(flags, conf.JSONEncodeFlags...)
+
+	if len(flags) == 0 {
+		return "json_encode($value)"
+	}
+
+	return "json_encode($value, " + strings.Join(flags, " | ") + ")"
+}
+
+// DecodeJSONFlags returns the flags the generated decodeJson helper passes to
+// json_decode.
+func (c *Config) DecodeJSONFlags() string {
+	return strings.Join(append([]string{"JSON_THROW_ON_ERROR"}, c.JSONDecodeFlags...), " | ")
+}
+
+// columnComment returns the comment of col. Query columns carry no comment,
+// so it is looked up on the catalog column name of table.
+func columnComment(req *plugin.GenerateRequest, col *plugin.Column, table *plugin.Identifier, name string) string {
+	if col.Comment != "" || table == nil {
+		return col.Comment
+	}
+
+	schemaName := table.Schema
+	if schemaName == "" {
+		schemaName = req.GetCatalog().GetDefaultSchema()
+	}
+
+	for _, schema := range req.GetCatalog().GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+
+		for _, tbl := range schema.Tables {
+			if tbl.Rel.GetName() != table.Name {
+				continue
+			}
+
+			for _, c := range tbl.Columns {
+				if c.Name == name {
+					return c.Comment
+				}
+			}
+		}
+	}
+
+	return ""
+}
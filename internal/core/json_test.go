@@ -0,0 +1,101 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestJSONClassType(t *testing.T) {
+	author := &plugin.Identifier{Name: "author"}
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{DefaultSchema: "main"}}
+	col := &plugin.Column{Name: "data", Type: &plugin.Identifier{Name: "JSON"}}
+	base := phpType{Name: "array", DataType: "JSON", Engine: "sqlite"}
+
+	if _, ok := jsonClassType(req, &Config{}, col, author, "data", base); ok {
+		t.Errorf("Expected no mapping without json_classes")
+	}
+
+	conf := &Config{JSONClasses: map[string]string{"author.data": `App\AuthorProfile`}}
+	typ, ok := jsonClassType(req, conf, col, author, "data", base)
+	if !ok || typ.Name != `\App\AuthorProfile` ||
+		typ.Decode != `\App\AuthorProfile::fromArray(self::jsonArray(json_decode($value, true), __FUNCTION__, 'data'))` ||
+		typ.Encode != "json_encode($value)" || typ.EncodedType != "string" {
+		t.Errorf("jsonClassType() = %+v, %v", typ, ok)
+	}
+
+	if _, ok := jsonClassType(req, conf, col, author, "data", phpType{Name: "string", DataType: "TEXT"}); ok {
+		t.Errorf("Expected no mapping for a TEXT column")
+	}
+}
+
+func TestJSONClassType_Annotation(t *testing.T) {
+	author := &plugin.Identifier{Name: "author"}
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{
+		DefaultSchema: "public",
+		Schemas: []*plugin.Schema{{
+			Name: "public",
+			Tables: []*plugin.Table{{
+				Rel: author,
+				Columns: []*plugin.Column{
+					{Name: "data", Type: &plugin.Identifier{Name: "json"}, Comment: `Profile data @sqlc-json \App\AuthorProfile`},
+				},
+			}},
+		}},
+	}}
+	base := phpType{Name: "array", DataType: "json", Engine: "mysql"}
+
+	// Query columns have no comment and are resolved through the catalog.
+	col := &plugin.Column{Name: "data", Type: &plugin.Identifier{Name: "json"}, Table: author, OriginalName: "data"}
+	typ, ok := jsonClassType(req, &Config{}, col, author, "data", base)
+	if !ok || typ.Name != `\App\AuthorProfile` {
+		t.Errorf("jsonClassType() = %+v, %v", typ, ok)
+	}
+
+	if _, ok := jsonClassType(req, &Config{}, col, author, "other", base); ok {
+		t.Errorf("Expected no mapping for an unannotated column")
+	}
+}
+
+func TestJSONType(t *testing.T) {
+	col := &plugin.Column{Name: "data", Type: &plugin.Identifier{Name: "JSON"}}
+	base := phpType{Name: "array", DataType: "JSON", Engine: "sqlite"}
+
+	if _, ok := jsonType(&Config{}, col, base); ok {
+		t.Errorf("Expected no mapping without JSON options")
+	}
+
+	cases := []struct {
+		conf   Config
+		typ    phpType
+		name   string
+		decode string
+		encode string
+	}{
+		{
+			Config{JSONDecodeFlags: []string{"JSON_BIGINT_AS_STRING"}}, base, "array",
+			"json_decode($value, true, 512, JSON_BIGINT_AS_STRING) ?? []", "json_encode($value)",
+		},
+		{
+			Config{JSONDecode: "object"}, phpType{Name: "array", IsNull: true}, "array|\\stdClass",
+			"json_decode($value, false)", "json_encode($value)",
+		},
+		{
+			Config{JSONThrowOnError: true, JSONEncodeFlags: []string{"JSON_UNESCAPED_UNICODE"}}, base, "array",
+			"self::decodeJson($value, true, __FUNCTION__, 'data')", "json_encode($value, JSON_THROW_ON_ERROR | JSON_UNESCAPED_UNICODE)",
+		},
+	}
+	for _, tc := range cases {
+		typ, ok := jsonType(&tc.conf, col, tc.typ)
+		if !ok || typ.Name != tc.name || typ.Decode != tc.decode || typ.Encode != tc.encode || typ.EncodedType != "string" {
+			t.Errorf("jsonType(%+v) = %+v, %v", tc.conf, typ, ok)
+		}
+	}
+}
+
+func TestConfig_DecodeJSONFlags(t *testing.T) {
+	conf := &Config{JSONDecodeFlags: []string{"JSON_BIGINT_AS_STRING", "JSON_OBJECT_AS_ARRAY"}}
+	if got := conf.DecodeJSONFlags(); got != "JSON_THROW_ON_ERROR | JSON_BIGINT_AS_STRING | JSON_OBJECT_AS_ARRAY" {
+		t.Errorf("DecodeJSONFlags() = %q", got)
+	}
+}
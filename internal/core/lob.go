@@ -0,0 +1,86 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	blobString = "string"
+	blobStream = "stream"
+	blobObject = "blob"
+
+	// lobEncodedType marks types bound with PDO::PARAM_LOB and hydrated
+	// through PDOStatement::bindColumn.
+	lobEncodedType = "resource"
+
+	// lobStreamHelper is the generated helper that turns a LOB column into a
+	// stream.
+	lobStreamHelper = "self::lobStream("
+)
+
+var blobDataTypes = map[string]bool{
+	"blob":       true,
+	"tinyblob":   true,
+	"mediumblob": true,
+	"longblob":   true,
+}
+
+// blobType maps a BLOB column to a stream resource or to the generated Blob
+// class wrapping one, as selected by the blob_type option. BINARY and
+// VARBINARY columns hold short values such as hashes and stay strings.
+func blobType(conf *Config, t phpType) (phpType, bool) {
+	if !blobDataTypes[strings.ToLower(t.DataType)] {
+		return t, false
+	}
+
+	switch conf.BlobType {
+	case blobStream:
+		// Resources have no type declaration.
+		t.Name = "mixed"
+		t.DocName = "resource"
+		t.Decode = lobStreamHelper + "$value)"
+	case blobObject:
+		t.Name = "Blob"
+		t.Decode = "Blob::from($value)"
+		t.Encode = "$value->stream"
+	default:
+		return t, false
+	}
+
+	t.EncodedType = lobEncodedType
+	return t, true
+}
+
+func (t phpType) isLOB() bool {
+	return t.EncodedType == lobEncodedType
+}
+
+// HasLOBs reports whether the result has LOB columns. Such results bind
+// every column with BindColumns and fetch rows with PDO::FETCH_BOUND, so LOBs
+// are streamed instead of buffered in a row array.
+func (v QueryValue) HasLOBs() bool {
+	for _, t := range v.types() {
+		if t.isLOB() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BindColumns returns the PDOStatement::bindColumn calls that fetch the
+// columns of the result into $col0, $col1, ... by column index, LOB columns
+// as streams.
+func (v QueryValue) BindColumns() []string {
+	var out []string
+	for i, t := range v.types() {
+		if t.isLOB() {
+			out = append(out, fmt.Sprintf("$stmt->bindColumn(%d, $col%d, \\PDO::PARAM_LOB);", i+1, i))
+		} else {
+			out = append(out, fmt.Sprintf("$stmt->bindColumn(%d, $col%d);", i+1, i))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBlobType(t *testing.T) {
+	blob := phpType{Name: "string", DataType: "longblob", Engine: "mysql"}
+	if _, ok := blobType(&Config{}, blob); ok {
+		t.Errorf("Expected no mapping without blob_type")
+	}
+
+	typ, ok := blobType(&Config{BlobType: "stream"}, blob)
+	if !ok || typ.Name != "mixed" || typ.DocName != "resource" || typ.Decode != "self::lobStream($value)" || !typ.isLOB() {
+		t.Errorf("blobType(stream) = %+v, %v", typ, ok)
+	}
+
+	typ, ok = blobType(&Config{BlobType: "blob"}, blob)
+	if !ok || typ.Name != "Blob" || typ.Decode != "Blob::from($value)" || typ.Encode != "$value->stream" || !typ.isLOB() {
+		t.Errorf("blobType(blob) = %+v, %v", typ, ok)
+	}
+
+	if _, ok := blobType(&Config{BlobType: "stream"}, phpType{Name: "string", DataType: "varbinary"}); ok {
+		t.Errorf("Expected no mapping for a VARBINARY column")
+	}
+}
+
+func TestBlobType_Bindings(t *testing.T) {
+	typ, _ := blobType(&Config{BlobType: "stream"}, phpType{Name: "string", DataType: "BLOB", IsNull: true})
+	expected := "$stmt->bindValue(1, $data, $data === null ? \\PDO::PARAM_NULL : \\PDO::PARAM_LOB);"
+	if got := bindValue("1", typ, "$data"); got != expected {
+		t.Errorf("bindValue() = %q, want %q", got, expected)
+	}
+
+	if got := typ.DocString(); got != "resource|null" {
+		t.Errorf("DocString() = %q", got)
+	}
+}
+
+func TestQueryValue_BindColumns(t *testing.T) {
+	lob, _ := blobType(&Config{BlobType: "blob"}, phpType{Name: "string", DataType: "blob"})
+	qv := QueryValue{Struct: &ModelClass{Name: "Document", Fields: []Field{
+		{Name: "id", Type: phpType{Name: "int"}},
+		{Name: "content", Type: lob},
+	}}}
+
+	if !qv.HasLOBs() {
+		t.Errorf("Expected HasLOBs()")
+	}
+
+	expected := []string{"$stmt->bindColumn(1, $col0);", "$stmt->bindColumn(2, $col1, \\PDO::PARAM_LOB);"}
+	if got := qv.BindColumns(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("BindColumns() = %q, want %q", got, expected)
+	}
+
+	if got := qv.BoundResultSet(); got != "$col0, Blob::from($col1)" {
+		t.Errorf("BoundResultSet() = %q", got)
+	}
+
+	if got := qv.ResultSet(); got != "$row[0], Blob::from($row[1])" {
+		t.Errorf("ResultSet() = %q", got)
+	}
+
+	scalar := QueryValue{Typ: lob}
+	if got := scalar.BoundResultSet(); got != "Blob::from($col0)" {
+		t.Errorf("BoundResultSet() = %q", got)
+	}
+
+	if got := scalar.ResultSet(); got != "Blob::from($row)" {
+		t.Errorf("ResultSet() = %q", got)
+	}
+}
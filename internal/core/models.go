@@ -1,6 +1,11 @@
 package core
 
-import "github.com/sqlc-dev/plugin-sdk-go/plugin"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
 
 type Query struct {
 	ClassName    string
@@ -13,6 +18,27 @@ type Query struct {
 	SourceName   string
 	Ret          QueryValue
 	Arg          Params
+
+	// CopyFromRow is the placeholder group of one :copyfrom row, e.g. "(?, ?)".
+	CopyFromRow string
+	// CopyFromRowsPerChunk keeps each :copyfrom INSERT within the engine's
+	// bound parameter limit.
+	CopyFromRowsPerChunk int
+}
+
+// PrepareSQL returns the PHP expression passed to PDO::prepare. Queries using
+// sqlc.slice() get every /*SLICE:name*/? marker rewritten at runtime.
+func (q Query) PrepareSQL() string {
+	sql := q.ConstantName
+	for _, f := range q.Arg.ModelClass.Fields {
+		if !f.Type.IsArray {
+			continue
+		}
+
+		sql = fmt.Sprintf("self::expandSlice(%s, '%s', $%s)", sql, f.OriginalColumnName, f.Name)
+	}
+
+	return sql
 }
 
 type Field struct {
@@ -23,6 +49,8 @@ type Field struct {
 	Comment            string
 	Default            string
 	DocType            string
+	// Embed is the table model of a sqlc.embed() field.
+	Embed *ModelClass
 }
 
 type ModelClass struct {
@@ -32,6 +60,17 @@ type ModelClass struct {
 	Comment string
 }
 
+// HasDocTypes reports whether a field needs a PHPDoc type.
+func (m *ModelClass) HasDocTypes() bool {
+	for _, f := range m.Fields {
+		if f.DocType != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
 type QueryValue struct {
 	Name   string
 	Struct *ModelClass
@@ -54,21 +93,159 @@ func (v QueryValue) Type() string {
 	panic("no type for QueryValue: " + v.Name)
 }
 
-func (v QueryValue) IsClass() bool {
-	switch v.Typ.Name {
-	case "int", "float", "string", "bool":
-		return false
-	default:
-		return true
+// DocType is Type written for PHPDoc, e.g. "int|null" instead of "?int".
+func (v QueryValue) DocType() string {
+	if v.Typ != (phpType{}) {
+		return v.Typ.DocString()
+	}
+
+	return v.Type()
+}
+
+// ListDocType is the PHPDoc type of a list of results, e.g. "(int|null)[]".
+func (v QueryValue) ListDocType() string {
+	t := v.DocType()
+	if strings.Contains(t, "|") {
+		return "(" + t + ")[]"
+	}
+
+	return t + "[]"
+}
+
+// NullableType is the PHP return type of a result that may be missing.
+func (v QueryValue) NullableType() string {
+	if v.Typ == (phpType{}) {
+		return "?" + v.Type()
 	}
+
+	if v.Typ.IsArray {
+		return "?" + v.Typ.String()
+	}
+
+	return nullableType(v.Typ.String())
+}
+
+// NullableDocType is NullableType written for PHPDoc, e.g. "int|null".
+func (v QueryValue) NullableDocType() string {
+	if v.Typ.IsEnumList || v.Typ.DocName != "" {
+		t := v.Typ
+		t.IsNull = true
+		return t.DocString()
+	}
+
+	t := v.NullableType()
+	if strings.HasPrefix(t, "?") {
+		return t[1:] + "|null"
+	}
+
+	return t
+}
+
+// HasDocType reports whether PHPDoc describes a single column result more
+// precisely than its declared type.
+func (v QueryValue) HasDocType() bool {
+	return !v.IsClass() && v.Typ.HasDocType()
+}
+
+// IsClass reports whether the result is hydrated into a generated class, as
+// opposed to a single column value such as an int, a decoded JSON array or
+// mixed.
+func (v QueryValue) IsClass() bool {
+	return v.Struct != nil
 }
 
 type QueriesTmplCtx struct {
+	Package       string
+	Queries       []Query
+	Settings      *plugin.Settings
+	SqlcVersion   string
+	SourceName    string
+	ThrowOnNoRows bool
+	// JSONDecodeFlags are the json_decode flags of the decodeJson helper.
+	JSONDecodeFlags string
+	// Converters are the constructor dependencies of QueriesImpl besides PDO.
+	Converters []ConverterDependency
+}
+
+// types returns the types of all hydrated values, including those of embedded
+// models.
+func (v QueryValue) types() []phpType {
+	if !v.IsClass() {
+		return []phpType{v.Typ}
+	}
+
+	var out []phpType
+	for _, f := range v.Struct.Fields {
+		if f.Embed != nil {
+			for _, ef := range f.Embed.Fields {
+				out = append(out, ef.Type)
+			}
+			continue
+		}
+
+		out = append(out, f.Type)
+	}
+
+	return out
+}
+
+// usesHelper reports whether a query hydrates a value through the generated
+// helper whose call starts with prefix.
+func (c QueriesTmplCtx) usesHelper(prefix string) bool {
+	for _, q := range c.Queries {
+		for _, t := range q.Ret.types() {
+			if strings.Contains(t.Decode, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// HasDateTimes reports whether a query hydrates text into \DateTimeImmutable
+// and needs the parseDateTime helper.
+func (c QueriesTmplCtx) HasDateTimes() bool { return c.usesHelper(parseDateTimeHelper) }
+
+// HasLOBStreams reports whether a query hydrates LOB columns through the
+// lobStream helper.
+func (c QueriesTmplCtx) HasLOBStreams() bool { return c.usesHelper(lobStreamHelper) }
+
+// HasJSONDecoder reports whether a query hydrates JSON through the decodeJson
+// helper.
+func (c QueriesTmplCtx) HasJSONDecoder() bool { return c.usesHelper(decodeJsonHelper) }
+
+// HasJSONArrays reports whether a query hydrates a JSON class through the
+// jsonArray helper.
+func (c QueriesTmplCtx) HasJSONArrays() bool { return c.usesHelper(jsonArrayHelper) }
+
+func (c QueriesTmplCtx) HasSlices() bool {
+	for _, q := range c.Queries {
+		if q.Arg.HasSlices() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EnumClass is a string-backed PHP enum generated from a catalog enum.
+type EnumClass struct {
+	Name    string
+	Comment string
+	Cases   []EnumCase
+}
+
+type EnumCase struct {
+	Name string
+	// Value is the quoted PHP string the case is backed by.
+	Value string
+}
+
+type EnumTmplCtx struct {
 	Package     string
-	Queries     []Query
-	Settings    *plugin.Settings
+	Enum        *EnumClass
 	SqlcVersion string
-	SourceName  string
 }
 
 type ModelsTmplCtx struct {
@@ -84,19 +261,81 @@ type phpType struct {
 	IsNull   bool
 	DataType string
 	Engine   string
+	// Decode and Encode are the expressions of a type override, see Override.
+	Decode string
+	Encode string
+	// EncodedType is the PHP type Encode produces, when it differs from Name.
+	EncodedType string
+	// IsEnumList marks a MySQL SET column, a list of the enum Name.
+	IsEnumList bool
+	// DocName is the PHPDoc type when Name cannot declare it, e.g. resource.
+	DocName string
 }
 
 func (t phpType) String() string {
 	v := t.Name
 	if t.IsArray {
 		v = "array"
-	} else if t.IsNull && t.Name != "mixed" {
-		v = "?" + v
+	} else if t.IsEnumList {
+		v = "array"
+		if t.IsNull {
+			v = "?" + v
+		}
+	} else if t.IsNull {
+		v = nullableType(v)
 	}
 
 	return v
 }
 
+// nullableType makes the PHP type v accept null, e.g. "?int" or
+// "int|string|null".
+func nullableType(v string) string {
+	switch {
+	case v == "mixed", strings.HasPrefix(v, "?"), strings.Contains(v, "null"):
+		return v
+	case strings.Contains(v, "|"):
+		return v + "|null"
+	}
+
+	return "?" + v
+}
+
+// DocString is String written for PHPDoc, e.g. "list<Tag>|null" for a
+// nullable SET column.
+func (t phpType) DocString() string {
+	if t.IsEnumList {
+		v := "list<" + t.Name + ">"
+		if t.IsNull {
+			v += "|null"
+		}
+
+		return v
+	}
+
+	if t.DocName != "" {
+		v := t.DocName
+		if t.IsNull {
+			v += "|null"
+		}
+
+		return v
+	}
+
+	v := t.String()
+	if strings.HasPrefix(v, "?") {
+		return v[1:] + "|null"
+	}
+
+	return v
+}
+
+// HasDocType reports whether PHPDoc can describe the type more precisely
+// than the native type declaration.
+func (t phpType) HasDocType() bool {
+	return t.IsEnumList || t.DocName != ""
+}
+
 func (t phpType) IsBoolean() bool {
 	return t.Name == "bool"
 }
@@ -116,3 +355,14 @@ func (t phpType) IsFloat() bool {
 func (t phpType) IsString() bool {
 	return t.Name == "string"
 }
+
+// IsBinary reports whether the column stores raw bytes, which PDO binds as a
+// large object.
+func (t phpType) IsBinary() bool {
+	switch strings.ToLower(t.DataType) {
+	case "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return true
+	}
+
+	return false
+}
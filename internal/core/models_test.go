@@ -91,3 +91,112 @@ func TestPhpType_String_NullableMixed(t *testing.T) {
 		t.Errorf("phpType.String() for nullable mixed = %q, want %q", got, expected)
 	}
 }
+
+func TestQueryValue_NullableType(t *testing.T) {
+	cases := []struct {
+		name     string
+		qv       QueryValue
+		expected string
+		doc      string
+	}{
+		{"struct", QueryValue{Struct: &ModelClass{Name: "Author"}}, "?Author", "Author|null"},
+		{"scalar", QueryValue{Typ: phpType{Name: "int"}}, "?int", "int|null"},
+		{"nullable scalar", QueryValue{Typ: phpType{Name: "int", IsNull: true}}, "?int", "int|null"},
+		{"mixed", QueryValue{Typ: phpType{Name: "mixed", IsNull: true}}, "mixed", "mixed"},
+	}
+
+	for _, tc := range cases {
+		if got := tc.qv.NullableType(); got != tc.expected {
+			t.Errorf("NullableType() (%s) = %q, want %q", tc.name, got, tc.expected)
+		}
+
+		if got := tc.qv.NullableDocType(); got != tc.doc {
+			t.Errorf("NullableDocType() (%s) = %q, want %q", tc.name, got, tc.doc)
+		}
+	}
+}
+
+func TestQueryValue_DocType(t *testing.T) {
+	qv := QueryValue{Typ: phpType{Name: "string", IsNull: true}}
+	if got := qv.DocType(); got != "string|null" {
+		t.Errorf("DocType() = %q, want %q", got, "string|null")
+	}
+}
+
+func TestQueryValue_IsClass(t *testing.T) {
+	for _, name := range []string{"int", "array", "mixed"} {
+		qv := QueryValue{Typ: phpType{Name: name}}
+		if qv.IsClass() {
+			t.Errorf("IsClass() should be false for a single %s column", name)
+		}
+	}
+
+	qv := QueryValue{Struct: &ModelClass{Name: "Author"}}
+	if !qv.IsClass() {
+		t.Errorf("IsClass() should be true for a row class")
+	}
+}
+
+func TestQueryValue_ListDocType(t *testing.T) {
+	qv := QueryValue{Typ: phpType{Name: "array", IsNull: true}}
+	if got := qv.ListDocType(); got != "(array|null)[]" {
+		t.Errorf("ListDocType() = %q", got)
+	}
+
+	qv = QueryValue{Struct: &ModelClass{Name: "Author"}}
+	if got := qv.ListDocType(); got != "Author[]" {
+		t.Errorf("ListDocType() = %q", got)
+	}
+}
+
+func TestPhpType_DocString(t *testing.T) {
+	tests := []struct {
+		typ      phpType
+		expected string
+	}{
+		{phpType{Name: "int"}, "int"},
+		{phpType{Name: "int", IsNull: true}, "int|null"},
+		{phpType{Name: "Tag", IsEnumList: true}, "list<Tag>"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.typ.DocString(); got != tt.expected {
+			t.Errorf("DocString(%+v) = %q, want %q", tt.typ, got, tt.expected)
+		}
+	}
+}
+
+func TestParams_DocParams(t *testing.T) {
+	mc := &ModelClass{Fields: []Field{
+		{Name: "id", Type: phpType{Name: "int"}},
+		{Name: "tags", Type: phpType{Name: "Tag", IsEnumList: true}, DocType: "list<Tag>"},
+	}}
+	got := Params{ModelClass: mc}.DocParams()
+	if len(got) != 1 || got[0] != "list<Tag> $tags" {
+		t.Errorf("DocParams() = %q", got)
+	}
+	if !mc.HasDocTypes() {
+		t.Errorf("Expected HasDocTypes to be true")
+	}
+}
+
+func TestNullableType_Union(t *testing.T) {
+	tests := map[string]string{
+		"int":             "?int",
+		"?int":            "?int",
+		"int|string":      "int|string|null",
+		"int|string|null": "int|string|null",
+		"mixed":           "mixed",
+	}
+
+	for in, expected := range tests {
+		if got := nullableType(in); got != expected {
+			t.Errorf("nullableType(%q) = %q, want %q", in, got, expected)
+		}
+	}
+
+	v := QueryValue{Typ: phpType{Name: "int|string"}}
+	if got := v.NullableDocType(); got != "int|string|null" {
+		t.Errorf("NullableDocType() = %q", got)
+	}
+}
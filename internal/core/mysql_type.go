@@ -1,6 +1,8 @@
 package core
 
 import (
+	"strings"
+
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
 	"github.com/sqlc-dev/plugin-sdk-go/sdk"
 )
@@ -13,13 +15,33 @@ func mysqlType(col *plugin.Column) string {
 	case "varchar", "text", "char", "tinytext", "mediumtext", "longtext":
 		return "string"
 
-	case "int", "integer", "smallint", "mediumint", "year", "bigint":
+	case "bigint":
+		// BIGINT UNSIGNED exceeds PHP_INT_MAX above 2^63 - 1.
+		if col.Unsigned {
+			return "int|string"
+		}
+		return "int"
+
+	case "int", "integer", "smallint", "mediumint", "year":
+		return "int"
+
+	case "tinyint":
+		// MySQL has no boolean type, BOOL is an alias for TINYINT(1).
+		if col.Length == 1 && !col.Unsigned {
+			return "bool"
+		}
+		return "int"
+
+	case "bit":
+		if col.Length == 1 {
+			return "bool"
+		}
 		return "int"
 
 	case "blob", "binary", "varbinary", "tinyblob", "mediumblob", "longblob":
 		return "string"
 
-	case "double", "double precision", "real":
+	case "double", "double precision", "real", "float":
 		return "float"
 
 	case "decimal", "dec", "fixed":
@@ -31,7 +53,7 @@ func mysqlType(col *plugin.Column) string {
 	case "date", "datetime", "time", "timestamp":
 		return "string"
 
-	case "boolean", "bool", "tinyint":
+	case "boolean", "bool":
 		return "bool"
 
 	case "json":
@@ -44,3 +66,43 @@ func mysqlType(col *plugin.Column) string {
 		return "mixed"
 	}
 }
+
+// unsignedBigIntType hydrates BIGINT UNSIGNED columns range-safely: values
+// that fit PHP_INT_MAX become int and larger ones stay numeric strings, unless
+// the unsigned_bigint_type option selects "string", "bcmath" or a class
+// constructed from the numeric string.
+func unsignedBigIntType(conf *Config, col *plugin.Column, t phpType) (phpType, bool) {
+	if t.Engine != "mysql" || !col.Unsigned || strings.ToLower(t.DataType) != "bigint" {
+		return t, false
+	}
+
+	switch conf.UnsignedBigIntType {
+	case "", "int|string":
+		t.Name = "int|string"
+		t.Decode = "filter_var($value, FILTER_VALIDATE_INT, FILTER_NULL_ON_FAILURE) ?? (string) $value"
+	case "string":
+		t.Name = "string"
+		t.Decode = "(string) $value"
+	default:
+		t.Name = qualifiedClass(conf.UnsignedBigIntType)
+		if conf.UnsignedBigIntType == decimalBCMath {
+			t.Name = "\\BcMath\\Number"
+		}
+		t.Decode = "new " + t.Name + "((string) $value)"
+		t.Encode = "(string) $value"
+		t.EncodedType = "string"
+	}
+
+	return t, true
+}
+
+// bitType casts BIT(n) columns wider than one bit, which PDO may return as
+// numeric strings, to int.
+func bitType(t phpType) (phpType, bool) {
+	if t.Engine != "mysql" || strings.ToLower(t.DataType) != "bit" || t.Name != "int" {
+		return t, false
+	}
+
+	t.Decode = "(int) $value"
+	return t, true
+}
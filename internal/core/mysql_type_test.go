@@ -31,3 +31,72 @@ func TestMysqlType(t *testing.T) {
 		}
 	}
 }
+
+func TestMysqlType_Length(t *testing.T) {
+	cases := []struct {
+		colType  string
+		length   int32
+		unsigned bool
+		expected string
+	}{
+		{"tinyint", 1, false, "bool"},
+		{"tinyint", 4, false, "int"},
+		{"tinyint", -1, false, "int"},
+		{"tinyint", 1, true, "int"},
+		{"bit", 1, false, "bool"},
+		{"bit", 8, false, "int"},
+		{"float", -1, false, "float"},
+		{"mediumint", 8, true, "int"},
+		{"year", 4, false, "int"},
+		{"varbinary", 16, false, "string"},
+	}
+	for _, tc := range cases {
+		col := &plugin.Column{Type: &plugin.Identifier{Name: tc.colType}, Length: tc.length, Unsigned: tc.unsigned}
+		if got := mysqlType(col); got != tc.expected {
+			t.Errorf("mysqlType(%s(%d), unsigned=%v) = %q, want %q", tc.colType, tc.length, tc.unsigned, got, tc.expected)
+		}
+	}
+}
+
+func TestBitType(t *testing.T) {
+	typ, ok := bitType(phpType{Name: "int", DataType: "bit", Engine: "mysql"})
+	if !ok || typ.Decode != "(int) $value" {
+		t.Errorf("bitType() = %+v, %v", typ, ok)
+	}
+
+	if _, ok := bitType(phpType{Name: "bool", DataType: "bit", Engine: "mysql"}); ok {
+		t.Errorf("Expected no mapping for BIT(1)")
+	}
+}
+
+func TestMysqlType_Unsigned(t *testing.T) {
+	col := &plugin.Column{Type: &plugin.Identifier{Name: "bigint"}, Unsigned: true}
+	if got := mysqlType(col); got != "int|string" {
+		t.Errorf("mysqlType(bigint unsigned) = %q", got)
+	}
+
+	col.Type.Name = "int"
+	if got := mysqlType(col); got != "int" {
+		t.Errorf("mysqlType(int unsigned) = %q", got)
+	}
+}
+
+func TestUnsignedBigIntType(t *testing.T) {
+	col := &plugin.Column{Type: &plugin.Identifier{Name: "bigint"}, Unsigned: true}
+	base := phpType{Name: "int|string", DataType: "bigint", Engine: "mysql"}
+
+	typ, ok := unsignedBigIntType(&Config{}, col, base)
+	if !ok || typ.Decode != "filter_var($value, FILTER_VALIDATE_INT, FILTER_NULL_ON_FAILURE) ?? (string) $value" {
+		t.Errorf("unsignedBigIntType() = %+v, %v", typ, ok)
+	}
+
+	typ, _ = unsignedBigIntType(&Config{UnsignedBigIntType: "bcmath"}, col, base)
+	if typ.Name != "\\BcMath\\Number" || typ.Encode != "(string) $value" {
+		t.Errorf("unsignedBigIntType() = %+v", typ)
+	}
+
+	col.Unsigned = false
+	if _, ok := unsignedBigIntType(&Config{}, col, base); ok {
+		t.Errorf("Expected no mapping for a signed bigint")
+	}
+}
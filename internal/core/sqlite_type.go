@@ -7,29 +7,49 @@ import (
 	"github.com/sqlc-dev/plugin-sdk-go/sdk"
 )
 
+// sqliteType maps a declared column type following SQLite's type affinity
+// rules, see https://www.sqlite.org/datatype3.html#determination_of_column_affinity.
 func sqliteType(col *plugin.Column) string {
-	columnType := strings.ToLower(sdk.DataType(col.Type))
+	columnType := strings.ToLower(strings.TrimSpace(sdk.DataType(col.Type)))
 
-	switch columnType {
-	case "text", "varchar", "char", "clob":
-		return "string"
-	case "integer", "int", "bigint", "smallint", "tinyint":
-		return "int"
-	case "real", "double", "float":
-		return "float"
-	case "blob":
-		return "string"
-	case "boolean":
+	// Declared types with a more precise PHP type than their affinity.
+	switch baseDataType(columnType) {
+	case "boolean", "bool":
 		return "bool"
-	case "date", "datetime":
-		return "string"
-	case "numeric":
-		return "string"
-	case "json":
+	case "json", "jsonb":
 		return "array"
 	case "any":
+		// ANY columns of STRICT tables store values unchanged.
 		return "mixed"
-	default:
+	}
+
+	switch {
+	case strings.Contains(columnType, "int"):
+		return "int"
+	case strings.Contains(columnType, "char"), strings.Contains(columnType, "clob"), strings.Contains(columnType, "text"):
+		return "string"
+	case strings.Contains(columnType, "blob"):
+		return "string"
+	case columnType == "":
+		// BLOB affinity without a declared type stores values unchanged.
 		return "mixed"
+	case strings.Contains(columnType, "real"), strings.Contains(columnType, "floa"), strings.Contains(columnType, "doub"):
+		return "float"
+	case strings.Contains(columnType, "date"), strings.Contains(columnType, "time"):
+		// NUMERIC affinity, but the date and time functions work on text.
+		return "string"
+	default:
+		// NUMERIC affinity keeps integers, reals and text that is not a number.
+		return "int|float|string"
 	}
 }
+
+// baseDataType strips the length, precision or scale of a declared type, so
+// DECIMAL(10,2) becomes DECIMAL.
+func baseDataType(dataType string) string {
+	if i := strings.Index(dataType, "("); i != -1 {
+		return strings.TrimSpace(dataType[:i])
+	}
+
+	return dataType
+}
@@ -18,10 +18,19 @@ func TestSqliteType(t *testing.T) {
 		{"blob", "blob", "string"},
 		{"boolean", "boolean", "bool"},
 		{"date", "date", "string"},
-		{"numeric", "numeric", "string"},
+		{"numeric", "numeric", "int|float|string"},
 		{"json", "json", "array"},
 		{"any", "any", "mixed"},
-		{"unknown", "unknown", "mixed"},
+		{"unknown", "unknown", "int|float|string"},
+		{"varchar(255)", "VARCHAR(255)", "string"},
+		{"nvarchar", "NVARCHAR", "string"},
+		{"unsigned big int", "UNSIGNED BIG INT", "int"},
+		{"int8", "INT8", "int"},
+		{"double precision", "DOUBLE PRECISION", "float"},
+		{"float", "FLOAT", "float"},
+		{"datetime2", "DATETIME2", "string"},
+		{"decimal(10,5)", "DECIMAL(10,5)", "int|float|string"},
+		{"no type", "", "mixed"},
 	}
 	for _, tc := range cases {
 		col := &plugin.Column{Type: &plugin.Identifier{Name: tc.colType}}
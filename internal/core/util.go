@@ -2,7 +2,11 @@ package core
 
 import (
 	"bytes"
+	"fmt"
+	"regexp"
 	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/sdk"
 )
 
 func indent(s string, n int, firstIndent int) string {
@@ -53,3 +57,46 @@ func parseSQLCParamComments(comments []string) map[string]paramOverride {
 	}
 	return out
 }
+
+var identifierChar = regexp.MustCompile(`^[A-Za-z0-9_\x80-\xff]`)
+
+// Nowdoc returns s as a PHP nowdoc literal, which is never interpolated or
+// unescaped. The closing marker is chosen so that no line of s can end the
+// literal early.
+func Nowdoc(s string) string {
+	marker := "SQL"
+	for i := 1; nowdocEndsAt(s, marker); i++ {
+		marker = fmt.Sprintf("SQL_%d", i)
+	}
+
+	return "<<<'" + marker + "'\n" + s + "\n" + marker
+}
+
+// nowdocEndsAt reports whether a line of s would be read as the closing
+// marker, which PHP accepts indented and followed by any non-identifier.
+func nowdocEndsAt(s, marker string) bool {
+	for _, l := range strings.Split(s, "\n") {
+		l = strings.TrimLeft(l, " \t")
+		if strings.HasPrefix(l, marker) && !identifierChar.MatchString(l[len(marker):]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DocComment escapes s for use inside a /** */ docblock.
+func DocComment(s string) string {
+	return strings.ReplaceAll(s, "*/", "*\\/")
+}
+
+// DoubleSlashComment renders s as // comments. A "?>" would close the PHP
+// tag even inside a comment, so it is broken up.
+func DoubleSlashComment(s string) string {
+	return sdk.DoubleSlashComment(strings.ReplaceAll(s, "?>", "? >"))
+}
+
+// phpStringLiteral quotes s as a single-quoted PHP string.
+func phpStringLiteral(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
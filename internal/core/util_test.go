@@ -56,3 +56,42 @@ func TestIndent(t *testing.T) {
 		})
 	}
 }
+
+func TestNowdoc(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain", `SELECT "$a" FROM t`, "<<<'SQL'\nSELECT \"$a\" FROM t\nSQL"},
+		{"marker line", "SELECT 1 AS\nSQL", "<<<'SQL_1'\nSELECT 1 AS\nSQL\nSQL_1"},
+		{"indented marker", "SELECT 1 AS\n  SQL;\nSQL_1", "<<<'SQL_2'\nSELECT 1 AS\n  SQL;\nSQL_1\nSQL_2"},
+		{"identifier prefix", "SELECT\nSQL_CALC_FOUND_ROWS 1", "<<<'SQL'\nSELECT\nSQL_CALC_FOUND_ROWS 1\nSQL"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Nowdoc(tc.input); got != tc.expected {
+				t.Errorf("Nowdoc() = %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDocComment(t *testing.T) {
+	if got := DocComment("ends */ here"); got != `ends *\/ here` {
+		t.Errorf("DocComment() = %q", got)
+	}
+}
+
+func TestDoubleSlashComment(t *testing.T) {
+	if got := DoubleSlashComment("a ?> b\nc"); got != "// a ? > b\n// c" {
+		t.Errorf("DoubleSlashComment() = %q", got)
+	}
+}
+
+func TestPhpStringLiteral(t *testing.T) {
+	if got := phpStringLiteral(`Europe/O'Neil\x`); got != `'Europe/O\'Neil\\x'` {
+		t.Errorf("phpStringLiteral() = %s", got)
+	}
+}
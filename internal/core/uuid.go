@@ -0,0 +1,67 @@
+package core
+
+import "github.com/sqlc-dev/plugin-sdk-go/plugin"
+
+const (
+	uuidSymfony = "symfony"
+	uuidRamsey  = "ramsey"
+)
+
+// uuidCodec converts a UUID library's class from and to its 16 byte binary
+// and its 36 character text representation.
+type uuidCodec struct {
+	class      string
+	fromBinary string
+	toBinary   string
+	fromString string
+	toString   string
+}
+
+var uuidCodecs = map[string]uuidCodec{
+	uuidSymfony: {
+		class:      "\\Symfony\\Component\\Uid\\Uuid",
+		fromBinary: "\\Symfony\\Component\\Uid\\Uuid::fromBinary($value)",
+		toBinary:   "$value->toBinary()",
+		fromString: "\\Symfony\\Component\\Uid\\Uuid::fromString($value)",
+		toString:   "$value->toRfc4122()",
+	},
+	uuidRamsey: {
+		class:      "\\Ramsey\\Uuid\\UuidInterface",
+		fromBinary: "\\Ramsey\\Uuid\\Uuid::fromBytes($value)",
+		toBinary:   "$value->getBytes()",
+		fromString: "\\Ramsey\\Uuid\\Uuid::fromString($value)",
+		toString:   "$value->toString()",
+	},
+}
+
+// uuidType maps a column listed in uuid_columns to the class of the
+// uuid_type library. Binary columns such as BINARY(16) hold the 16 bytes of
+// the UUID, all others its text form.
+func uuidType(conf *Config, defaultSchema string, table *plugin.Identifier, name string, t phpType) (phpType, bool) {
+	codec, ok := uuidCodecs[conf.UUIDType]
+	if !ok || t.IsArray {
+		return t, false
+	}
+
+	matched := false
+	for _, column := range conf.UUIDColumns {
+		if (Override{Column: column}).matchesColumn(t.Engine, defaultSchema, table, name) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return t, false
+	}
+
+	t.Name = codec.class
+	t.DocName = ""
+	t.EncodedType = "string"
+	if t.IsBinary() {
+		t.Decode, t.Encode = codec.fromBinary, codec.toBinary
+	} else {
+		t.Decode, t.Encode = codec.fromString, codec.toString
+	}
+
+	return t, true
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestUUIDType(t *testing.T) {
+	book := &plugin.Identifier{Name: "book"}
+	binary := phpType{Name: "string", DataType: "binary", Engine: "mysql"}
+	text := phpType{Name: "string", DataType: "char", Engine: "mysql", IsNull: true}
+
+	if _, ok := uuidType(&Config{}, "public", book, "id", binary); ok {
+		t.Errorf("Expected no mapping without uuid_type")
+	}
+
+	conf := &Config{UUIDType: "symfony", UUIDColumns: []string{"book.id", "book.ref"}}
+	typ, ok := uuidType(conf, "public", book, "id", binary)
+	if !ok || typ.Name != "\\Symfony\\Component\\Uid\\Uuid" ||
+		typ.Decode != "\\Symfony\\Component\\Uid\\Uuid::fromBinary($value)" || typ.Encode != "$value->toBinary()" {
+		t.Errorf("uuidType() = %+v, %v", typ, ok)
+	}
+
+	typ, _ = uuidType(conf, "public", book, "ref", text)
+	if typ.Decode != "\\Symfony\\Component\\Uid\\Uuid::fromString($value)" || typ.Encode != "$value->toRfc4122()" {
+		t.Errorf("uuidType() = %+v", typ)
+	}
+
+	if _, ok := uuidType(conf, "public", book, "title", text); ok {
+		t.Errorf("Expected no mapping for an unlisted column")
+	}
+
+	conf.UUIDType = "ramsey"
+	typ, _ = uuidType(conf, "public", book, "id", binary)
+	if typ.Name != "\\Ramsey\\Uuid\\UuidInterface" || typ.Decode != "\\Ramsey\\Uuid\\Uuid::fromBytes($value)" || typ.Encode != "$value->getBytes()" {
+		t.Errorf("uuidType() = %+v", typ)
+	}
+
+	expected := "$stmt->bindValue(1, $id->getBytes(), \\PDO::PARAM_LOB);"
+	if got := bindValue("1", typ, "$id"); got != expected {
+		t.Errorf("bindValue() = %q, want %q", got, expected)
+	}
+}
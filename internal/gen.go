@@ -24,6 +24,21 @@ var queryImplTemplate string
 //go:embed tmpl/query_interface.tmpl
 var queryInterfaceTemplate string
 
+//go:embed tmpl/enum.tmpl
+var enumTemplate string
+
+//go:embed tmpl/no_rows_exception.tmpl
+var noRowsExceptionTemplate string
+
+//go:embed tmpl/json_decode_exception.tmpl
+var jsonDecodeExceptionTemplate string
+
+//go:embed tmpl/blob.tmpl
+var blobTemplate string
+
+//go:embed tmpl/column_converter.tmpl
+var columnConverterTemplate string
+
 func Offset(v int) int {
 	return v + 1
 }
@@ -64,27 +79,41 @@ func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateR
 		}
 	}
 
-	modelClasses := core.BuildDataClasses(req)
-	queries, emitModelClasses, err := core.BuildQueries(req, modelClasses)
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	modelClasses := core.BuildDataClasses(req, &conf)
+	queries, emitModelClasses, err := core.BuildQueries(req, &conf, modelClasses)
 	if err != nil {
 		return nil, err
 	}
 
 	funcMap := template.FuncMap{
 		"lowerTitle": sdk.LowerTitle,
-		"comment":    sdk.DoubleSlashComment,
+		"comment":    core.DoubleSlashComment,
+		"docComment": core.DocComment,
+		"nowdoc":     core.Nowdoc,
 		"offset":     Offset,
 	}
 
 	modelsFile := template.Must(template.New("table").Funcs(funcMap).Parse(modelsTemplate))
 	sqlFile := template.Must(template.New("table").Funcs(funcMap).Parse(queryImplTemplate))
 	ifaceFile := template.Must(template.New("table").Funcs(funcMap).Parse(queryInterfaceTemplate))
+	enumFile := template.Must(template.New("table").Funcs(funcMap).Parse(enumTemplate))
+	exceptionFile := template.Must(template.New("table").Funcs(funcMap).Parse(noRowsExceptionTemplate))
+	jsonExceptionFile := template.Must(template.New("table").Funcs(funcMap).Parse(jsonDecodeExceptionTemplate))
+	blobFile := template.Must(template.New("table").Funcs(funcMap).Parse(blobTemplate))
+	converterFile := template.Must(template.New("table").Funcs(funcMap).Parse(columnConverterTemplate))
 
 	queryTemplateContext := core.QueriesTmplCtx{
-		Settings:    req.Settings,
-		Package:     conf.Package,
-		Queries:     queries,
-		SqlcVersion: req.SqlcVersion,
+		Settings:        req.Settings,
+		Package:         conf.Package,
+		Queries:         queries,
+		SqlcVersion:     req.SqlcVersion,
+		ThrowOnNoRows:   conf.ThrowOnNoRows,
+		JSONDecodeFlags: conf.DecodeJSONFlags(),
+		Converters:      core.BuildConverterDependencies(&conf),
 	}
 
 	output := map[string]string{}
@@ -96,6 +125,30 @@ func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateR
 		return nil, err
 	}
 
+	if conf.ThrowOnNoRows {
+		if err := executeTemplate("NoRowsException.php", exceptionFile, queryTemplateContext, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.JSONThrowOnError {
+		if err := executeTemplate("JsonDecodeException.php", jsonExceptionFile, queryTemplateContext, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.BlobType == "blob" {
+		if err := executeTemplate("Blob.php", blobFile, queryTemplateContext, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(conf.Converters) > 0 {
+		if err := executeTemplate("ColumnConverter.php", converterFile, queryTemplateContext, output); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, modelClass := range modelClasses {
 		if err := executeTemplate(modelClass.Name+".php", modelsFile, &core.ModelsTmplCtx{
 			Package:     conf.Package,
@@ -116,6 +169,16 @@ func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateR
 		}
 	}
 
+	for _, enum := range core.BuildEnums(req) {
+		if err := executeTemplate(enum.Name+".php", enumFile, &core.EnumTmplCtx{
+			Package:     conf.Package,
+			SqlcVersion: req.SqlcVersion,
+			Enum:        enum,
+		}, output); err != nil {
+			return nil, err
+		}
+	}
+
 	resp := plugin.GenerateResponse{}
 	for filename, code := range output {
 		resp.Files = append(resp.Files, &plugin.File{
@@ -83,3 +83,429 @@ func TestBooleanBindings(t *testing.T) {
 
 	runGoldenTest(t, testCase)
 }
+
+func TestSqlcSliceSqlite(t *testing.T) {
+	testCase := TestCase{
+		Name:    "sqlc_slice_sqlite",
+		Engine:  "sqlite",
+		Package: "Test\\SqlcSliceSqlite",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestSqlcSliceMysql(t *testing.T) {
+	testCase := TestCase{
+		Name:    "sqlc_slice_mysql",
+		Engine:  "mysql",
+		Package: "Test\\SqlcSliceMysql",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestExecRowsSqlite(t *testing.T) {
+	testCase := TestCase{
+		Name:    "execrows_sqlite",
+		Engine:  "sqlite",
+		Package: "Test\\ExecRowsSqlite",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestExecRowsMysql(t *testing.T) {
+	testCase := TestCase{
+		Name:    "execrows_mysql",
+		Engine:  "mysql",
+		Package: "Test\\ExecRowsMysql",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestExecLastId(t *testing.T) {
+	testCase := TestCase{
+		Name:    "execlastid",
+		Engine:  "sqlite",
+		Package: "Test\\ExecLastId",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestCopyFromSqlite(t *testing.T) {
+	testCase := TestCase{
+		Name:    "copyfrom_sqlite",
+		Engine:  "sqlite",
+		Package: "Test\\CopyFromSqlite",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestCopyFromMysql(t *testing.T) {
+	testCase := TestCase{
+		Name:    "copyfrom_mysql",
+		Engine:  "mysql",
+		Package: "Test\\CopyFromMysql",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestBatch(t *testing.T) {
+	testCase := TestCase{
+		Name:    "batch",
+		Engine:  "sqlite",
+		Package: "Test\\Batch",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestSqlcEmbed(t *testing.T) {
+	testCase := TestCase{
+		Name:    "sqlc_embed",
+		Engine:  "sqlite",
+		Package: "Test\\SqlcEmbed",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestNullableOne(t *testing.T) {
+	testCase := TestCase{
+		Name:    "nullable_one",
+		Engine:  "sqlite",
+		Package: "Test\\NullableOne",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestNullableOneThrowOnNoRows(t *testing.T) {
+	testCase := TestCase{
+		Name:    "nullable_one_throw",
+		Engine:  "sqlite",
+		Package: "Test\\NullableOneThrow",
+		Options: map[string]any{"throw_on_no_rows": true},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestSingleColumnResults(t *testing.T) {
+	testCase := TestCase{
+		Name:    "single_column_results",
+		Engine:  "sqlite",
+		Package: "Test\\SingleColumnResults",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestHostileSql(t *testing.T) {
+	testCase := TestCase{
+		Name:    "hostile_sql",
+		Engine:  "mysql",
+		Package: "Test\\HostileSql",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestTypedBindings(t *testing.T) {
+	testCase := TestCase{
+		Name:    "typed_bindings",
+		Engine:  "mysql",
+		Package: "Test\\TypedBindings",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestTypeOverrides(t *testing.T) {
+	testCase := TestCase{
+		Name:    "type_overrides",
+		Engine:  "mysql",
+		Package: "Test\\TypeOverrides",
+		Options: map[string]any{
+			"overrides": []map[string]any{
+				{
+					"db_type":  "decimal",
+					"php_type": "Brick\\Math\\BigDecimal",
+					"decode":   "\\Brick\\Math\\BigDecimal::of($value)",
+					"encode":   "(string) $value",
+				},
+				{
+					"db_type":  "decimal",
+					"nullable": true,
+					"php_type": "Brick\\Math\\BigDecimal",
+					"decode":   "\\Brick\\Math\\BigDecimal::of($value)",
+					"encode":   "(string) $value",
+				},
+				{
+					"db_type":  "date",
+					"nullable": true,
+					"engine":   "sqlite",
+					"php_type": "int",
+				},
+			},
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestColumnOverrides(t *testing.T) {
+	testCase := TestCase{
+		Name:    "column_overrides",
+		Engine:  "sqlite",
+		Package: "Test\\ColumnOverrides",
+		Options: map[string]any{
+			"overrides": []map[string]any{
+				{
+					"column":   "book.isbn",
+					"php_type": "App\\Isbn",
+					"decode":   "new \\App\\Isbn($value)",
+					"encode":   "$value->value",
+				},
+				{
+					"column":   "user.settings",
+					"php_type": "App\\UserSettings",
+					"decode":   "\\App\\UserSettings::fromJson($value)",
+					"encode":   "json_encode($value)",
+				},
+			},
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestDateTimeMySQL(t *testing.T) {
+	testCase := TestCase{
+		Name:    "date_time_mysql",
+		Engine:  "mysql",
+		Package: "Test\\DateTimeMySQL",
+		Options: map[string]any{
+			"date_time_immutable": true,
+			"date_time_timezone":  "UTC",
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestDateTimeSQLiteEpoch(t *testing.T) {
+	testCase := TestCase{
+		Name:    "date_time_sqlite_epoch",
+		Engine:  "sqlite",
+		Package: "Test\\DateTimeSQLiteEpoch",
+		Options: map[string]any{
+			"date_time_immutable":      true,
+			"sqlite_date_time_storage": "unixepoch",
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestDecimalBcMath(t *testing.T) {
+	testCase := TestCase{
+		Name:    "decimal_bcmath",
+		Engine:  "mysql",
+		Package: "Test\\DecimalBcMath",
+		Options: map[string]any{"decimal_type": "bcmath"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestDecimalClass(t *testing.T) {
+	testCase := TestCase{
+		Name:    "decimal_class",
+		Engine:  "sqlite",
+		Package: "Test\\DecimalClass",
+		Options: map[string]any{"decimal_type": "App\\Money\\Decimal"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestEnums(t *testing.T) {
+	testCase := TestCase{
+		Name:    "enums",
+		Engine:  "mysql",
+		Package: "Test\\Enums",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestMySQLSet(t *testing.T) {
+	testCase := TestCase{
+		Name:    "mysql_set",
+		Engine:  "mysql",
+		Package: "Test\\MySQLSet",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestUnsignedBigInt(t *testing.T) {
+	testCase := TestCase{
+		Name:    "unsigned_bigint",
+		Engine:  "mysql",
+		Package: "Test\\UnsignedBigInt",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestUnsignedBigIntClass(t *testing.T) {
+	testCase := TestCase{
+		Name:    "unsigned_bigint_class",
+		Engine:  "mysql",
+		Package: "Test\\UnsignedBigIntClass",
+		Options: map[string]any{"unsigned_bigint_type": "App\\UInt64"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestSQLiteAffinity(t *testing.T) {
+	testCase := TestCase{
+		Name:    "sqlite_affinity",
+		Engine:  "sqlite",
+		Package: "Test\\SQLiteAffinity",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestMySQLTypes(t *testing.T) {
+	testCase := TestCase{
+		Name:    "mysql_types",
+		Engine:  "mysql",
+		Package: "Test\\MySQLTypes",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestJSONClasses(t *testing.T) {
+	testCase := TestCase{
+		Name:    "json_classes",
+		Engine:  "sqlite",
+		Package: "Test\\JSONClasses",
+		Options: map[string]any{"json_classes": map[string]any{
+			"author.data":     "App\\AuthorProfile",
+			"author.settings": "App\\AuthorSettings",
+		}},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestJSONCodec(t *testing.T) {
+	testCase := TestCase{
+		Name:    "json_codec",
+		Engine:  "sqlite",
+		Package: "Test\\JSONCodec",
+		Options: map[string]any{
+			"json_throw_on_error": true,
+			"json_decode_flags":   []string{"JSON_BIGINT_AS_STRING"},
+			"json_encode_flags":   []string{"JSON_UNESCAPED_UNICODE", "JSON_UNESCAPED_SLASHES"},
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestJSONObjects(t *testing.T) {
+	testCase := TestCase{
+		Name:    "json_objects",
+		Engine:  "sqlite",
+		Package: "Test\\JSONObjects",
+		Options: map[string]any{"json_decode": "object"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestNullableBindings(t *testing.T) {
+	testCase := TestCase{
+		Name:    "nullable_bindings",
+		Engine:  "sqlite",
+		Package: "Test\\NullableBindings",
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestBlobStream(t *testing.T) {
+	testCase := TestCase{
+		Name:    "blob_stream",
+		Engine:  "sqlite",
+		Package: "Test\\BlobStream",
+		Options: map[string]any{"blob_type": "stream"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestBlobObject(t *testing.T) {
+	testCase := TestCase{
+		Name:    "blob_object",
+		Engine:  "mysql",
+		Package: "Test\\BlobObject",
+		Options: map[string]any{"blob_type": "blob"},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestUuidSymfony(t *testing.T) {
+	testCase := TestCase{
+		Name:    "uuid_symfony",
+		Engine:  "mysql",
+		Package: "Test\\UuidSymfony",
+		Options: map[string]any{
+			"uuid_type":    "symfony",
+			"uuid_columns": []string{"book.id", "book.external_ref"},
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestUuidRamsey(t *testing.T) {
+	testCase := TestCase{
+		Name:    "uuid_ramsey",
+		Engine:  "sqlite",
+		Package: "Test\\UuidRamsey",
+		Options: map[string]any{
+			"uuid_type":    "ramsey",
+			"uuid_columns": []string{"book.id", "book.external_ref"},
+		},
+	}
+
+	runGoldenTest(t, testCase)
+}
+
+func TestColumnConverters(t *testing.T) {
+	testCase := TestCase{
+		Name:    "column_converters",
+		Engine:  "mysql",
+		Package: "Test\\ColumnConverters",
+		Options: map[string]any{"converters": []map[string]any{
+			{"column": "user.email", "class": "App\\Db\\EncryptedStringConverter"},
+			{"column": "user.balance", "class": "App\\Db\\MoneyConverter", "php_type": "App\\Money"},
+			{"column": "orders.total", "class": "App\\Db\\MoneyConverter", "php_type": "App\\Money"},
+		}},
+	}
+
+	runGoldenTest(t, testCase)
+}
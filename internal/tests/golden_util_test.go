@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
@@ -18,6 +20,8 @@ type TestCase struct {
 	Name    string
 	Engine  string
 	Package string
+	// Options are extra plugin options, written to sqlc.yaml as JSON values.
+	Options map[string]any
 }
 
 const YAML_TEMPLATE = `
@@ -35,7 +39,7 @@ sql:
     plugin: php
     options:
       package: "%s"
-`
+%s`
 
 func runGoldenTest(t *testing.T, tc TestCase) {
 	t.Helper()
@@ -115,12 +119,18 @@ func createSQLCConfig(t *testing.T, dir string, tc TestCase) {
 		t.Fatalf("Failed to get absolute project root: %v", err)
 	}
 
+	options, err := pluginOptionsYAML(tc.Options)
+	if err != nil {
+		t.Fatalf("Failed to encode plugin options: %v", err)
+	}
+
 	wasmPath := filepath.Join(projectRoot, "bin", "sqlc-gen-php.wasm")
 	config := fmt.Sprintf(
 		YAML_TEMPLATE,
 		wasmPath,
 		tc.Engine,
 		strings.ReplaceAll(tc.Package, `\`, `\\`),
+		options,
 	)
 
 	configPath := filepath.Join(dir, "sqlc.yaml")
@@ -129,6 +139,28 @@ func createSQLCConfig(t *testing.T, dir string, tc TestCase) {
 	}
 }
 
+// pluginOptionsYAML renders extra plugin options as YAML lines. JSON is valid
+// YAML, so every value is written in its JSON encoding.
+func pluginOptionsYAML(options map[string]any) (string, error) {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v, err := json.Marshal(options[k])
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&b, "      %s: %s\n", k, v)
+	}
+
+	return b.String(), nil
+}
+
 func runSQLCGenerate(t *testing.T, dir string) {
 	t.Helper()
 	cmd := exec.Command("sqlc", "generate")
@@ -8,12 +8,13 @@ declare(strict_types=1);
 
 namespace Test\ArgsWithDefaults;
 
-const insertAuthor = "-- name: insertAuthor :exec
+const insertAuthor = <<<'SQL'
+-- name: insertAuthor :exec
 INSERT INTO
     author (id, name, age)
 VALUES
     (?1, ?2, ?3)
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -27,7 +28,10 @@ final readonly class QueriesImpl implements Queries {
     public function insertAuthor(int $id, int $age, string $name = 'hello'): void
     {
         $stmt = $this->pdo->prepare(insertAuthor);
-        $stmt->execute([$id, $name, $age]);
+        $stmt->bindValue(1, $id, \PDO::PARAM_INT);
+        $stmt->bindValue(2, $name, \PDO::PARAM_STR);
+        $stmt->bindValue(3, $age, \PDO::PARAM_INT);
+        $stmt->execute();
     }
 
 }
@@ -8,23 +8,25 @@ declare(strict_types=1);
 
 namespace Test\Basic;
 
-const getAuthor = "-- name: getAuthor :one
+const getAuthor = <<<'SQL'
+-- name: getAuthor :one
 SELECT
     author_id, name
 FROM
     author
 WHERE
     author_id = ?
-";
+SQL;
 
-const listAuthors = "-- name: listAuthors :many
+const listAuthors = <<<'SQL'
+-- name: listAuthors :many
 SELECT
     author_id, name
 FROM
     author
 ORDER BY
     name
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -36,7 +38,8 @@ final readonly class QueriesImpl implements Queries {
     public function getAuthor(int $authorId): ?Author
     {
         $stmt = $this->pdo->prepare(getAuthor);
-        $stmt->execute([$authorId]);
+        $stmt->bindValue(1, $authorId, \PDO::PARAM_INT);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         {
             $count = count($results);
@@ -0,0 +1,31 @@
+-- name: UpdatePrice :batchexec
+UPDATE
+    book
+SET
+    price = ?
+WHERE
+    book_id = ?;
+
+-- name: GetBook :batchone
+SELECT
+    *
+FROM
+    book
+WHERE
+    book_id = ?;
+
+-- name: ListBooksByAuthor :batchmany
+SELECT
+    *
+FROM
+    book
+WHERE
+    author_id = ?;
+
+-- name: GetTitle :batchone
+SELECT
+    title
+FROM
+    book
+WHERE
+    book_id = ?;
@@ -0,0 +1,6 @@
+CREATE TABLE document (
+    id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    name TEXT NOT NULL,
+    content LONGBLOB NOT NULL,
+    thumbnail BLOB
+);
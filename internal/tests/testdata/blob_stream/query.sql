@@ -0,0 +1,55 @@
+-- name: GetDocument :one
+SELECT
+    id, name, content, thumbnail
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListDocuments :many
+SELECT
+    id, name, content, thumbnail
+FROM
+    document
+ORDER BY
+    id;
+
+-- name: GetContent :one
+SELECT
+    content
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListThumbnails :many
+SELECT
+    thumbnail
+FROM
+    document
+ORDER BY
+    id;
+
+-- name: GetContents :batchone
+SELECT
+    content
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListDocumentsByName :batchmany
+SELECT
+    id, name, content, thumbnail
+FROM
+    document
+WHERE
+    name = ?
+ORDER BY
+    id;
+
+-- name: CreateDocument :exec
+INSERT INTO
+    document (name, content, thumbnail)
+VALUES
+    (?, ?, ?);
@@ -0,0 +1,6 @@
+CREATE TABLE document (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    content BLOB NOT NULL,
+    thumbnail BLOB
+);
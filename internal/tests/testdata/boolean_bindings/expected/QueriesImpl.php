@@ -8,7 +8,8 @@ declare(strict_types=1);
 
 namespace Test\BooleanBindings;
 
-const listFlags = "-- name: listFlags :many
+const listFlags = <<<'SQL'
+-- name: listFlags :many
 SELECT
     id,
     name,
@@ -17,14 +18,15 @@ FROM
     feature_flags
 ORDER BY
     id
-";
+SQL;
 
-const setFlag = "-- name: setFlag :exec
+const setFlag = <<<'SQL'
+-- name: setFlag :exec
 INSERT INTO
     feature_flags (id, name, enabled)
 VALUES
     (?, ?, ?)
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -51,7 +53,10 @@ final readonly class QueriesImpl implements Queries {
     public function setFlag(int $id, string $name, bool $enabled): void
     {
         $stmt = $this->pdo->prepare(setFlag);
-        $stmt->execute([$id, $name, ($enabled ? 1 : 0)]);
+        $stmt->bindValue(1, $id, \PDO::PARAM_INT);
+        $stmt->bindValue(2, $name, \PDO::PARAM_STR);
+        $stmt->bindValue(3, $enabled, \PDO::PARAM_BOOL);
+        $stmt->execute();
     }
 
 }
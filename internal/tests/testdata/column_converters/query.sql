@@ -0,0 +1,29 @@
+-- name: GetUser :one
+SELECT
+    id, email, balance
+FROM
+    user
+WHERE
+    email = ?;
+
+-- name: ListOrderTotals :many
+SELECT
+    total
+FROM
+    orders
+WHERE
+    user_id = ?;
+
+-- name: UpdateBalance :exec
+UPDATE
+    user
+SET
+    balance = ?
+WHERE
+    id = ?;
+
+-- name: CreateOrders :batchexec
+INSERT INTO
+    orders (user_id, total)
+VALUES
+    (?, ?);
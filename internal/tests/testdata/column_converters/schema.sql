@@ -0,0 +1,11 @@
+CREATE TABLE user (
+    id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    email VARCHAR(255) NOT NULL,
+    balance DECIMAL(12, 2)
+);
+
+CREATE TABLE orders (
+    id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    total DECIMAL(12, 2) NOT NULL
+);
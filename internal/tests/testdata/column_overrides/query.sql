@@ -0,0 +1,30 @@
+-- name: GetBookByIsbn :one
+SELECT
+    id, isbn, title, owner_id
+FROM
+    book
+WHERE
+    isbn = ?;
+
+-- name: ListIsbns :many
+SELECT
+    isbn
+FROM
+    book
+ORDER BY
+    isbn;
+
+-- name: ListBooksWithOwner :many
+SELECT
+    book.isbn, book.title, user.name, user.settings
+FROM
+    book
+    JOIN user ON user.id = book.owner_id;
+
+-- name: UpdateUserSettings :exec
+UPDATE
+    user
+SET
+    settings = ?
+WHERE
+    id = ?;
@@ -0,0 +1,12 @@
+CREATE TABLE book (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    isbn TEXT NOT NULL,
+    title TEXT NOT NULL,
+    owner_id INTEGER NOT NULL
+);
+
+CREATE TABLE user (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    settings JSON
+);
@@ -0,0 +1,5 @@
+/* name: CreateAuthors :copyfrom */
+INSERT INTO
+    author (name, bio, active)
+VALUES
+    (?, ?, ?);
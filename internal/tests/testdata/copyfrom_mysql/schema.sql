@@ -0,0 +1,6 @@
+CREATE TABLE author (
+    author_id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL,
+    bio text,
+    active boolean NOT NULL DEFAULT true
+) ENGINE = InnoDB;
@@ -0,0 +1,6 @@
+CREATE TABLE author (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    bio TEXT,
+    active BOOLEAN NOT NULL DEFAULT 1
+);
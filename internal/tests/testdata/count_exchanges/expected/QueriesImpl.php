@@ -8,30 +8,33 @@ declare(strict_types=1);
 
 namespace Test\CountExchanges;
 
-const countExchanges = "-- name: countExchanges :one
+const countExchanges = <<<'SQL'
+-- name: countExchanges :one
 SELECT
     COUNT(*)
 FROM
     exchange
-";
+SQL;
 
-const getAllExchanges = "-- name: getAllExchanges :many
+const getAllExchanges = <<<'SQL'
+-- name: getAllExchanges :many
 SELECT
     id,
     name,
     COUNT(*)
 FROM
     exchange
-";
+SQL;
 
-const getIdByName = "-- name: getIdByName :one
+const getIdByName = <<<'SQL'
+-- name: getIdByName :one
 SELECT
     id
 FROM
     exchange
 WHERE
     name = ?1
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -83,7 +86,8 @@ final readonly class QueriesImpl implements Queries {
     public function getIdByName(string $name): ?int
     {
         $stmt = $this->pdo->prepare(getIdByName);
-        $stmt->execute([$name]);
+        $stmt->bindValue(1, $name, \PDO::PARAM_STR);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_COLUMN);
         {
             $count = count($results);
@@ -0,0 +1,31 @@
+-- name: GetEvent :one
+SELECT
+    id, name, starts_on, starts_at, ends_at, reminder
+FROM
+    event
+WHERE
+    id = ?;
+
+-- name: GetEventEnd :one
+SELECT
+    ends_at
+FROM
+    event
+WHERE
+    id = ?;
+
+-- name: ListEventsSince :many
+SELECT
+    id, name, starts_on, starts_at, ends_at, reminder
+FROM
+    event
+WHERE
+    starts_at >= ?
+ORDER BY
+    starts_at;
+
+-- name: CreateEvent :exec
+INSERT INTO
+    event (name, starts_on, starts_at, ends_at, reminder)
+VALUES
+    (?, ?, ?, ?, ?);
@@ -0,0 +1,8 @@
+CREATE TABLE event (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    starts_on DATE NOT NULL,
+    starts_at DATETIME NOT NULL,
+    ends_at TIMESTAMP NULL,
+    reminder TIME
+);
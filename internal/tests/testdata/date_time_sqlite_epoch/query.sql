@@ -0,0 +1,13 @@
+-- name: GetAuthorByCreatedAt :one
+SELECT
+    id, name, created_at, deleted_at
+FROM
+    author
+WHERE
+    created_at = ?;
+
+-- name: AddAuthor :exec
+INSERT INTO
+    author (name, created_at, deleted_at)
+VALUES
+    (?1, ?2, ?3);
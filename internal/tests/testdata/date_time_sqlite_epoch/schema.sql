@@ -0,0 +1,6 @@
+CREATE TABLE author (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    deleted_at DATETIME
+);
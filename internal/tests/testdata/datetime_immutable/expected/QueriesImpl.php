@@ -8,21 +8,23 @@ declare(strict_types=1);
 
 namespace Test\DateTimeImmutable;
 
-const addAuthor = "-- name: addAuthor :exec
+const addAuthor = <<<'SQL'
+-- name: addAuthor :exec
 INSERT INTO
     author (name, created_at)
 VALUES
     (?1, ?2)
-";
+SQL;
 
-const getAuthorByCreatedAt = "-- name: getAuthorByCreatedAt :one
+const getAuthorByCreatedAt = <<<'SQL'
+-- name: getAuthorByCreatedAt :one
 SELECT
     id, name, created_at
 FROM
     author
 WHERE
     created_at = ?
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -33,7 +35,9 @@ final readonly class QueriesImpl implements Queries {
     public function addAuthor(string $name, ?string $createdAt): void
     {
         $stmt = $this->pdo->prepare(addAuthor);
-        $stmt->execute([$name, $createdAt]);
+        $stmt->bindValue(1, $name, \PDO::PARAM_STR);
+        $stmt->bindValue(2, $createdAt, $createdAt === null ? \PDO::PARAM_NULL : \PDO::PARAM_STR);
+        $stmt->execute();
     }
 
     /**
@@ -43,7 +47,8 @@ final readonly class QueriesImpl implements Queries {
     public function getAuthorByCreatedAt(string $createdAt): ?Author
     {
         $stmt = $this->pdo->prepare(getAuthorByCreatedAt);
-        $stmt->execute([$createdAt]);
+        $stmt->bindValue(1, $createdAt, \PDO::PARAM_STR);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         {
             $count = count($results);
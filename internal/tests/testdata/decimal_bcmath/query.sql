@@ -0,0 +1,24 @@
+-- name: GetProduct :one
+SELECT
+    id, price, discount
+FROM
+    product
+WHERE
+    id = ?;
+
+-- name: GetDiscount :one
+SELECT
+    discount
+FROM
+    product
+WHERE
+    id = ?;
+
+-- name: UpdatePrice :exec
+UPDATE
+    product
+SET
+    price = ?,
+    discount = ?
+WHERE
+    id = ?;
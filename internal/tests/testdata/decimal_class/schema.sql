@@ -0,0 +1,5 @@
+CREATE TABLE product (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    price NUMERIC NOT NULL,
+    discount NUMERIC(10, 2)
+);
@@ -0,0 +1,29 @@
+-- name: GetBook :one
+SELECT
+    id, title, book_type, edition
+FROM
+    book
+WHERE
+    id = ?;
+
+-- name: ListBooksByType :many
+SELECT
+    id, title, book_type, edition
+FROM
+    book
+WHERE
+    book_type = ?;
+
+-- name: ListEditions :many
+SELECT DISTINCT
+    edition
+FROM
+    book;
+
+-- name: UpdateBookEdition :exec
+UPDATE
+    book
+SET
+    edition = ?
+WHERE
+    id = ?;
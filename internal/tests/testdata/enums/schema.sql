@@ -0,0 +1,6 @@
+CREATE TABLE book (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    book_type ENUM('hardcover', 'paperback', 'e-book') NOT NULL,
+    edition ENUM('1st edition', '2nd edition', '', 'class', 'a_b', 'a-b')
+);
@@ -0,0 +1,5 @@
+-- name: CreateAuthor :execlastid
+INSERT INTO
+    author (name)
+VALUES
+    (?);
@@ -0,0 +1,4 @@
+CREATE TABLE author (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL
+);
@@ -0,0 +1,15 @@
+/* name: UpdateBalance :execrows */
+UPDATE
+    account
+SET
+    balance = ?,
+    version = version + 1
+WHERE
+    id = ?
+    AND version = ?;
+
+/* name: DeleteEmptyAccounts :execrows */
+DELETE FROM
+    account
+WHERE
+    balance = 0;
@@ -0,0 +1,5 @@
+CREATE TABLE account (
+    id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    balance bigint NOT NULL,
+    version integer NOT NULL DEFAULT 0
+) ENGINE = InnoDB;
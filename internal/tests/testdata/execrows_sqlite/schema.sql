@@ -0,0 +1,5 @@
+CREATE TABLE account (
+    id INTEGER PRIMARY KEY,
+    balance INTEGER NOT NULL,
+    version INTEGER NOT NULL DEFAULT 0
+);
@@ -8,7 +8,8 @@ declare(strict_types=1);
 
 namespace Test\ExtraComments;
 
-const listEntities = "-- name: listEntities :many
+const listEntities = <<<'SQL'
+-- name: listEntities :many
 SELECT
     id, title, data, locked, locked_message, owner_id, created_at, updated_at
 FROM
@@ -28,7 +29,7 @@ WHERE
     )
 ORDER BY
     id DESC
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -43,7 +44,10 @@ final readonly class QueriesImpl implements Queries {
     public function listEntities(bool|null $locked = null, int|null $ownerId = null, string|null $title = null): array
     {
         $stmt = $this->pdo->prepare(listEntities);
-        $stmt->execute([$locked, $ownerId, $title]);
+        $stmt->bindValue(1, $locked, $locked === null ? \PDO::PARAM_NULL : \PDO::PARAM_BOOL);
+        $stmt->bindValue(2, $ownerId, $ownerId === null ? \PDO::PARAM_NULL : \PDO::PARAM_INT);
+        $stmt->bindValue(3, $title, $title === null ? \PDO::PARAM_NULL : \PDO::PARAM_STR);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         $ret = [];
         foreach ($results as $row) {
@@ -0,0 +1,20 @@
+/* name: FindByJsonPath :many */
+-- Matches "quoted" text, a \ backslash and $variables.
+-- Docblocks end with */ and PHP tags close with ?>
+SELECT
+    id,
+    body,
+    meta
+FROM
+    note
+WHERE
+    meta->>'$.author' = ?
+    AND body LIKE 'C:\\%'
+    AND body <> "$notAVariable {$either}";
+
+/* name: CountLegacy :one */
+SELECT
+    COUNT(*) AS
+SQL
+FROM
+    note;
@@ -0,0 +1,5 @@
+CREATE TABLE note (
+    id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    body text NOT NULL COMMENT 'May contain ?> or */',
+    meta json NOT NULL
+) ENGINE = InnoDB COMMENT = 'Notes ?> table';
@@ -0,0 +1,24 @@
+-- name: GetAuthor :one
+SELECT
+    author_id,
+    data,
+    settings,
+    tags
+FROM
+    author
+WHERE
+    author_id = ?;
+
+-- name: GetSettings :one
+SELECT
+    settings
+FROM
+    author
+WHERE
+    author_id = ?;
+
+-- name: CreateAuthor :exec
+INSERT INTO
+    author (data, settings, tags)
+VALUES
+    (?, ?, ?);
@@ -0,0 +1,6 @@
+CREATE TABLE author (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    data JSON NOT NULL,
+    settings JSON,
+    tags JSON
+);
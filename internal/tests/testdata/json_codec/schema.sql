@@ -0,0 +1,5 @@
+CREATE TABLE event (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    payload JSON NOT NULL,
+    metadata JSON
+);
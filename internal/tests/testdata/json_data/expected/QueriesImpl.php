@@ -8,14 +8,16 @@ declare(strict_types=1);
 
 namespace Test\JSON;
 
-const createAuthor = "-- name: createAuthor :exec
+const createAuthor = <<<'SQL'
+-- name: createAuthor :exec
 INSERT INTO
     author (data)
 VALUES
     (?)
-";
+SQL;
 
-const getAuthor = "-- name: getAuthor :one
+const getAuthor = <<<'SQL'
+-- name: getAuthor :one
 SELECT
     author_id,
     data
@@ -23,9 +25,10 @@ FROM
     author
 WHERE
     author_id = ?
-";
+SQL;
 
-const listAuthors = "-- name: listAuthors :many
+const listAuthors = <<<'SQL'
+-- name: listAuthors :many
 SELECT
     author_id,
     data
@@ -33,7 +36,7 @@ FROM
     author
 ORDER BY
     json_extract(data, '$.name')
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -44,7 +47,8 @@ final readonly class QueriesImpl implements Queries {
     public function createAuthor(array $data): void
     {
         $stmt = $this->pdo->prepare(createAuthor);
-        $stmt->execute([json_encode($data)]);
+        $stmt->bindValue(1, json_encode($data), \PDO::PARAM_STR);
+        $stmt->execute();
     }
 
     /**
@@ -54,7 +58,8 @@ final readonly class QueriesImpl implements Queries {
     public function getAuthor(int $authorId): ?Author
     {
         $stmt = $this->pdo->prepare(getAuthor);
-        $stmt->execute([$authorId]);
+        $stmt->bindValue(1, $authorId, \PDO::PARAM_INT);
+        $stmt->execute();
         $results = $stmt->fetchAll(\PDO::FETCH_NUM);
         {
             $count = count($results);
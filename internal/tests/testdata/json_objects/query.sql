@@ -0,0 +1,21 @@
+-- name: GetEvent :one
+SELECT
+    id, payload, metadata
+FROM
+    event
+WHERE
+    id = ?;
+
+-- name: ListMetadata :many
+SELECT
+    metadata
+FROM
+    event
+ORDER BY
+    id;
+
+-- name: CreateEvent :exec
+INSERT INTO
+    event (payload, metadata)
+VALUES
+    (?, ?);
@@ -0,0 +1,30 @@
+-- name: GetPost :one
+SELECT
+    id, title, status, tags, flags
+FROM
+    post
+WHERE
+    id = ?;
+
+-- name: GetPostTags :one
+SELECT
+    tags
+FROM
+    post
+WHERE
+    id = ?;
+
+-- name: ListPostFlags :many
+SELECT
+    flags
+FROM
+    post;
+
+-- name: UpdatePostTags :exec
+UPDATE
+    post
+SET
+    tags = ?,
+    flags = ?
+WHERE
+    id = ?;
@@ -0,0 +1,7 @@
+CREATE TABLE post (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    status ENUM('draft', 'published') NOT NULL,
+    tags SET('php', 'go', 'sql') NOT NULL,
+    flags SET('pinned', 'locked')
+);
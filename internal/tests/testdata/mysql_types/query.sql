@@ -0,0 +1,38 @@
+-- name: GetAllTypes :one
+SELECT
+    id, flag, tiny, tiny_unsigned, small, medium, big, ratio, score, price, active, mask, born, uuid, code, note, payload, settings, created_at
+FROM
+    all_types
+WHERE
+    id = ?;
+
+-- name: ListMasks :many
+SELECT
+    mask
+FROM
+    all_types
+ORDER BY
+    id;
+
+-- name: GetPayload :one
+SELECT
+    payload
+FROM
+    all_types
+WHERE
+    id = ?;
+
+-- name: UpdateAllTypes :exec
+UPDATE
+    all_types
+SET
+    flag = ?,
+    tiny = ?,
+    ratio = ?,
+    active = ?,
+    mask = ?,
+    born = ?,
+    uuid = ?,
+    payload = ?
+WHERE
+    id = ?;
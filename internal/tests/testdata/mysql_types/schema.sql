@@ -0,0 +1,21 @@
+CREATE TABLE all_types (
+    id INT UNSIGNED NOT NULL PRIMARY KEY,
+    flag TINYINT(1) NOT NULL,
+    tiny TINYINT NOT NULL,
+    tiny_unsigned TINYINT UNSIGNED,
+    small SMALLINT NOT NULL,
+    medium MEDIUMINT UNSIGNED NOT NULL,
+    big BIGINT NOT NULL,
+    ratio FLOAT,
+    score DOUBLE NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    active BIT(1) NOT NULL,
+    mask BIT(8),
+    born YEAR,
+    uuid VARBINARY(16) NOT NULL,
+    code CHAR(3) NOT NULL,
+    note TEXT,
+    payload JSON,
+    settings JSON NOT NULL,
+    created_at DATETIME NOT NULL
+);
@@ -0,0 +1,35 @@
+-- name: UpdateSetting :exec
+UPDATE
+    setting
+SET
+    enabled = ?,
+    data = ?,
+    note = ?
+WHERE
+    id = ?;
+
+-- name: ResetSetting :exec
+-- @sqlc-param ?bool $enabled=null
+-- @sqlc-param array|null $data=null
+UPDATE
+    setting
+SET
+    enabled = ?1,
+    data = ?2
+WHERE
+    id = ?3;
+
+-- name: UpdateSettings :batchexec
+UPDATE
+    setting
+SET
+    enabled = ?,
+    data = ?
+WHERE
+    id = ?;
+
+-- name: CreateSettings :copyfrom
+INSERT INTO
+    setting (id, enabled, data)
+VALUES
+    (?, ?, ?);
@@ -0,0 +1,6 @@
+CREATE TABLE setting (
+    id INTEGER PRIMARY KEY,
+    enabled BOOLEAN,
+    data JSON,
+    note TEXT
+);
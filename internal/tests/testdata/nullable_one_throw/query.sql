@@ -0,0 +1,31 @@
+-- name: GetAuthor :one
+SELECT
+    *
+FROM
+    author
+WHERE
+    author_id = ?;
+
+-- name: GetAge :one
+SELECT
+    age
+FROM
+    author
+WHERE
+    author_id = ?;
+
+-- name: GetBio :one
+SELECT
+    bio
+FROM
+    author
+WHERE
+    author_id = ?;
+
+-- name: GetName :one
+SELECT
+    name
+FROM
+    author
+WHERE
+    author_id = ?;
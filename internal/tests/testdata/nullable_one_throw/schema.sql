@@ -0,0 +1,6 @@
+CREATE TABLE author (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    age INTEGER,
+    bio TEXT
+);
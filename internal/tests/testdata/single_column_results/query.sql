@@ -0,0 +1,47 @@
+-- name: GetBody :one
+SELECT
+    body
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListBodies :many
+SELECT
+    body
+FROM
+    document
+ORDER BY
+    id;
+
+-- name: GetExtra :one
+SELECT
+    extra
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListExtras :many
+SELECT
+    extra
+FROM
+    document
+ORDER BY
+    id;
+
+-- name: GetPayload :one
+SELECT
+    payload
+FROM
+    document
+WHERE
+    id = ?;
+
+-- name: ListPayloads :many
+SELECT
+    payload
+FROM
+    document
+ORDER BY
+    id;
@@ -0,0 +1,6 @@
+CREATE TABLE document (
+    id INTEGER PRIMARY KEY,
+    body JSON NOT NULL,
+    extra JSON,
+    payload ANY
+);
@@ -8,14 +8,15 @@ declare(strict_types=1);
 
 namespace Test\BoolType;
 
-const getFlag = "-- name: getFlag :one
+const getFlag = <<<'SQL'
+-- name: getFlag :one
 SELECT
     flag
 from
     dummy
 LIMIT
     1
-";
+SQL;
 
 final readonly class QueriesImpl implements Queries {
     public function __construct(private \PDO $pdo) {}
@@ -0,0 +1,25 @@
+-- name: ListBooksWithAuthor :many
+SELECT
+    sqlc.embed(book),
+    sqlc.embed(author)
+FROM
+    book
+    JOIN author ON author.author_id = book.author_id;
+
+-- name: GetBookWithOptionalAuthor :one
+SELECT
+    book.title,
+    sqlc.embed(a)
+FROM
+    book
+    LEFT JOIN author a ON a.author_id = book.author_id
+WHERE
+    book.book_id = ?;
+
+-- name: GetAuthor :one
+SELECT
+    sqlc.embed(author)
+FROM
+    author
+WHERE
+    author_id = ?;
@@ -0,0 +1,12 @@
+CREATE TABLE author (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    bio TEXT
+);
+
+CREATE TABLE book (
+    book_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    author_id INTEGER,
+    title TEXT NOT NULL,
+    metadata JSON NOT NULL DEFAULT '{}'
+);
@@ -0,0 +1,23 @@
+/* name: ListBooksByIds :many */
+SELECT
+    *
+FROM
+    book
+WHERE
+    book_id IN (sqlc.slice(ids));
+
+/* name: ListBooksByAuthorAndTitles :many */
+SELECT
+    *
+FROM
+    book
+WHERE
+    author_id = ?
+    AND title IN (sqlc.slice(titles))
+    AND published IN (sqlc.slice(published));
+
+/* name: DeleteBooks :exec */
+DELETE FROM
+    book
+WHERE
+    book_id IN (sqlc.slice(ids));
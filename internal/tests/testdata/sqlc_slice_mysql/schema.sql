@@ -0,0 +1,6 @@
+CREATE TABLE book (
+    book_id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id integer NOT NULL,
+    title varchar(255) NOT NULL,
+    published boolean NOT NULL DEFAULT false
+) ENGINE = InnoDB;
@@ -0,0 +1,6 @@
+CREATE TABLE book (
+    book_id INTEGER PRIMARY KEY,
+    author_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    published BOOLEAN NOT NULL DEFAULT 0
+);
@@ -0,0 +1,13 @@
+-- name: GetMetric :one
+SELECT
+    id, sku, label, views, price, ratio, published_at, payload, version, amount
+FROM
+    metric
+WHERE
+    id = ?;
+
+-- name: CreateMetric :exec
+INSERT INTO
+    metric (id, sku, views, price, version)
+VALUES
+    (?, ?, ?, ?, ?);
@@ -0,0 +1,12 @@
+CREATE TABLE metric (
+    id INT8 NOT NULL PRIMARY KEY,
+    sku VARCHAR(255) NOT NULL,
+    label NVARCHAR(100),
+    views UNSIGNED BIG INT NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    ratio FLOAT,
+    published_at DATETIME2,
+    payload,
+    version STRING,
+    amount NUMERIC(10, 2)
+);
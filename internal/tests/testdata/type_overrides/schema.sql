@@ -0,0 +1,6 @@
+CREATE TABLE product (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    price DECIMAL(10, 2) NOT NULL,
+    discount DECIMAL(10, 2),
+    released_on DATE
+);
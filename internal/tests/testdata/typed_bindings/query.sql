@@ -0,0 +1,15 @@
+-- name: CreateAttachment :exec
+INSERT INTO
+    attachment (name, size, content, meta)
+VALUES
+    (?, ?, ?, ?);
+
+-- name: ListAttachmentsBySize :many
+SELECT
+    id, name, size, content, meta
+FROM
+    attachment
+WHERE
+    size > ?
+ORDER BY
+    id;
@@ -0,0 +1,7 @@
+CREATE TABLE attachment (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    size INT,
+    content LONGBLOB NOT NULL,
+    meta JSON
+);
@@ -0,0 +1,23 @@
+-- name: GetCounter :one
+SELECT
+    id, hits, small, delta
+FROM
+    counter
+WHERE
+    id = ?;
+
+-- name: GetHits :one
+SELECT
+    hits
+FROM
+    counter
+WHERE
+    id = ?;
+
+-- name: SetHits :exec
+UPDATE
+    counter
+SET
+    hits = ?
+WHERE
+    id = ?;
@@ -0,0 +1,6 @@
+CREATE TABLE counter (
+    id BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+    hits BIGINT UNSIGNED,
+    small INT UNSIGNED NOT NULL,
+    delta BIGINT NOT NULL
+);
@@ -0,0 +1,5 @@
+CREATE TABLE book (
+    id BLOB NOT NULL PRIMARY KEY,
+    title TEXT NOT NULL,
+    external_ref TEXT
+);
@@ -0,0 +1,21 @@
+-- name: GetBook :one
+SELECT
+    id, title, external_ref
+FROM
+    book
+WHERE
+    id = ?;
+
+-- name: ListBookIds :many
+SELECT
+    id
+FROM
+    book
+ORDER BY
+    title;
+
+-- name: CreateBook :exec
+INSERT INTO
+    book (id, title, external_ref)
+VALUES
+    (?, ?, ?);
@@ -0,0 +1,5 @@
+CREATE TABLE book (
+    id BINARY(16) NOT NULL PRIMARY KEY,
+    title TEXT NOT NULL,
+    external_ref CHAR(36)
+);